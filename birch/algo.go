@@ -0,0 +1,13 @@
+// Package birch provides a BIRCH implementation of online clustering for vectors ([]float64).
+// Pushed elements are summarized in a clustering feature tree of bounded size,
+// centroids being computed by a kmeans pass over the leaf entries.
+package birch
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new BIRCH algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, initializer core.Initializer, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, initializer, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
@@ -0,0 +1,75 @@
+package birch_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/birch"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+func rgen() *rand.Rand {
+	return rand.New(rand.NewSource(6305689164243))
+}
+
+// blobs returns size vectors drawn around (0, 0), (10, 10) and (-10, 10)
+func blobs(size int) (data []core.Elemt) {
+	var r = rgen()
+	var centers = [][]float64{{0, 0}, {10, 10}, {-10, 10}}
+	for i := 0; i < size; i++ {
+		var center = centers[i%3]
+		data = append(data, []float64{center[0] + r.NormFloat64(), center[1] + r.NormFloat64()})
+	}
+	return
+}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = birch.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}, RGen: rgen()}
+	var algo = birch.NewAlgo(conf, space, nil, kmeans.GivenInitializer)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_Initialization(t *testing.T) {
+	var algo = birch.NewAlgo(birch.Conf{K: 3}, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestInitialization(t, algo)
+}
+
+func Test_RunSyncGiven(t *testing.T) {
+	var conf = birch.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = birch.NewAlgo(conf, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestRunSyncGiven(t, algo)
+}
+
+func Test_RunSyncPP(t *testing.T) {
+	var conf = birch.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 5}, RGen: rgen()}
+	var algo = birch.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	// iterations over few leaf entries are fast, enough of them keep the algorithm running during the workflow
+	var conf = birch.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000000}, RGen: rgen()}
+	var algo = birch.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestWorkflow(t, algo)
+}
+
+func Test_Empty(t *testing.T) {
+	var conf = birch.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = birch.NewAlgo(conf, space, nil, kmeans.GivenInitializer)
+
+	if err := algo.Batch(); err == nil {
+		t.Error("error expected")
+	}
+}
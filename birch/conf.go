@@ -0,0 +1,62 @@
+package birch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of BIRCH
+type Conf struct {
+	core.CtrlConf
+	K          int
+	Branching  int     // maximal number of entries of tree nodes, 50 if 0
+	Threshold  float64 // initial maximal radius of leaf entries
+	MaxEntries int     // maximal number of leaf entries before the threshold is increased, 1000 if 0
+	BufferSize int     // maximal number of elements pushed between two iterations, 100 if 0
+	KMeansIter int     // number of kmeans iterations over leaf entries at each iteration, 10 if 0
+	RGen       *rand.Rand
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	switch {
+	case conf.K < 1:
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	case conf.Branching < 2:
+		err = fmt.Errorf("Illegal value for Branching: %v", conf.Branching)
+	case conf.Threshold < 0:
+		err = fmt.Errorf("Illegal value for Threshold: %v", conf.Threshold)
+	case conf.MaxEntries < conf.K:
+		err = fmt.Errorf("MaxEntries should not be lower than K: %v", conf.MaxEntries)
+	case conf.BufferSize < 1:
+		err = fmt.Errorf("Illegal value for BufferSize: %v", conf.BufferSize)
+	case conf.KMeansIter < 1:
+		err = fmt.Errorf("Illegal value for KMeansIter: %v", conf.KMeansIter)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.Branching == 0 {
+		conf.Branching = 50
+	}
+	if conf.MaxEntries == 0 {
+		conf.MaxEntries = 1000
+	}
+	if conf.BufferSize == 0 {
+		conf.BufferSize = 100
+	}
+	if conf.KMeansIter == 0 {
+		conf.KMeansIter = 10
+	}
+}
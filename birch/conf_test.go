@@ -0,0 +1,31 @@
+package birch_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/birch"
+)
+
+func TestBIRCH_ConfDefault(t *testing.T) {
+	var conf = birch.Conf{K: 3}
+	var err = conf.Verify()
+	if err != nil || conf.Branching != 50 || conf.MaxEntries != 1000 || conf.BufferSize != 100 || conf.KMeansIter != 10 || conf.RGen == nil {
+		t.Error("default values expected", err, conf)
+	}
+}
+
+func TestBIRCH_ConfErrors(t *testing.T) {
+	var confs = []birch.Conf{
+		{K: -1},
+		{K: 3, Branching: 1},
+		{K: 3, Threshold: -1},
+		{K: 3, MaxEntries: 2},
+		{K: 3, BufferSize: -1},
+		{K: 3, KMeansIter: -1},
+	}
+	for _, conf := range confs {
+		if err := conf.Verify(); err == nil {
+			t.Error("error expected", conf)
+		}
+	}
+}
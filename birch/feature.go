@@ -0,0 +1,80 @@
+package birch
+
+import "math"
+
+// Feature summarizes weighted vectors by their total weight, linear sum and sum of squared norms
+type Feature struct {
+	N  int
+	LS []float64
+	SS float64
+}
+
+// NewFeature creates the feature of a single weighted vector
+func NewFeature(point []float64, weight int) (feature Feature) {
+	feature = Feature{N: weight, LS: make([]float64, len(point))}
+	for i, x := range point {
+		feature.LS[i] = float64(weight) * x
+		feature.SS += float64(weight) * x * x
+	}
+	return
+}
+
+// Add merges another feature
+func (feature *Feature) Add(other Feature) {
+	if feature.LS == nil {
+		feature.LS = make([]float64, len(other.LS))
+	}
+	feature.N += other.N
+	for i := range feature.LS {
+		feature.LS[i] += other.LS[i]
+	}
+	feature.SS += other.SS
+}
+
+// Copy returns a copy of the feature
+func (feature Feature) Copy() Feature {
+	var ls = make([]float64, len(feature.LS))
+	copy(ls, feature.LS)
+	return Feature{N: feature.N, LS: ls, SS: feature.SS}
+}
+
+// Centroid returns the average of summarized vectors
+func (feature Feature) Centroid() []float64 {
+	var centroid = make([]float64, len(feature.LS))
+	for i := range centroid {
+		centroid[i] = feature.LS[i] / float64(feature.N)
+	}
+	return centroid
+}
+
+// SSE returns the sum of squared distances of summarized vectors to a point
+func (feature Feature) SSE(point []float64) float64 {
+	var sse = feature.SS
+	for i := range point {
+		sse += float64(feature.N)*point[i]*point[i] - 2*point[i]*feature.LS[i]
+	}
+	return math.Max(sse, 0)
+}
+
+// Radius returns the root mean squared distance of summarized vectors to their centroid
+func (feature Feature) Radius() float64 {
+	return math.Sqrt(feature.SSE(feature.Centroid()) / float64(feature.N))
+}
+
+// mergedRadius returns the radius of the merge of two features
+func mergedRadius(feature1 Feature, feature2 Feature) float64 {
+	var merged = feature1.Copy()
+	merged.Add(feature2)
+	return merged.Radius()
+}
+
+// dist returns the euclidean distance between the centroids of two features
+func dist(feature1 Feature, feature2 Feature) float64 {
+	var sum = 0.
+	var n1, n2 = float64(feature1.N), float64(feature2.N)
+	for i := range feature1.LS {
+		var diff = feature1.LS[i]/n1 - feature2.LS[i]/n2
+		sum += diff * diff
+	}
+	return math.Sqrt(sum)
+}
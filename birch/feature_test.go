@@ -0,0 +1,32 @@
+package birch_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/birch"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func TestFeature(t *testing.T) {
+	var feature = birch.NewFeature([]float64{1, 2}, 2)
+	feature.Add(birch.NewFeature([]float64{4, 6}, 1))
+	feature.Add(birch.NewFeature([]float64{1, 2}, 1))
+
+	test.AssertEqual(t, 4, feature.N)
+	test.AssertArrayAlmostEqual(t, []float64{7, 12}, feature.LS)
+	test.AssertAlmostEqual(t, 3*5+52, feature.SS)
+	test.AssertArrayAlmostEqual(t, []float64{1.75, 3}, feature.Centroid())
+
+	// squared distances to (1, 2) are 0 (3 times) and 25
+	test.AssertAlmostEqual(t, 25, feature.SSE([]float64{1, 2}))
+	test.AssertAlmostEqual(t, math.Sqrt(feature.SSE([]float64{1.75, 3})/4), feature.Radius())
+}
+
+func TestFeature_Copy(t *testing.T) {
+	var feature = birch.NewFeature([]float64{1, 2}, 1)
+	var copied = feature.Copy()
+	copied.Add(feature)
+	test.AssertArrayAlmostEqual(t, []float64{1, 2}, feature.LS)
+	test.AssertArrayAlmostEqual(t, []float64{2, 4}, copied.LS)
+}
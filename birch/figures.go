@@ -0,0 +1,8 @@
+package birch
+
+const (
+	// Entries is the number of leaf entries of the tree
+	Entries = "entries"
+	// Threshold is the maximal radius of leaf entries
+	Threshold = "threshold"
+)
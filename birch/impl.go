@@ -0,0 +1,205 @@
+package birch
+
+import (
+	"encoding/gob"
+	"errors"
+	"io"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+// Impl algorithm implementation.
+// Pushed elements are pending until the next iteration inserts them in the tree.
+type Impl struct {
+	conf        Conf
+	initializer core.Initializer
+	tree        *Tree
+	c           chan weightedElemt
+	stats       []core.ClusterStats
+}
+
+// weightedElemt is a pending element
+type weightedElemt struct {
+	elemt  core.Elemt
+	weight int
+}
+
+// NewImpl creates a new BIRCH implementation, given elements are inserted in the tree
+func NewImpl(conf Conf, initializer core.Initializer, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	var impl = Impl{
+		conf:        conf,
+		initializer: initializer,
+		tree:        NewTree(conf.Branching, conf.Threshold, conf.MaxEntries),
+		c:           make(chan weightedElemt, conf.BufferSize),
+	}
+	for i := range data {
+		impl.tree.Insert(data[i].([]float64), 1)
+	}
+	return impl
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init inserts pending elements and initializes centroids among leaf entry centroids
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	var conf = model.Conf().(*Conf)
+	impl.insertPending()
+	if impl.tree.Len() == 0 {
+		err = errors.New("at least one element is needed")
+		return
+	}
+	var centroids, _ = impl.entries()
+	return impl.initializer(conf.K, centroids, model.Space(), conf.RGen)
+}
+
+// Iterate inserts pending elements in the tree then runs kmeans over leaf entries
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	impl.insertPending()
+
+	var centroids, weights = impl.entries()
+	var strategy kmeans.SeqStrategy
+	clust = model.Centroids()
+	for i := 0; i < conf.KMeansIter; i++ {
+		clust = strategy.Iterate(model.Space(), clust, centroids, weights)
+	}
+	impl.stats = impl.reduceStats(clust, model.Space())
+
+	runtimeFigures = core.RuntimeFigures{
+		Entries:   float64(impl.tree.Len()),
+		Threshold: impl.tree.Threshold(),
+	}
+	return
+}
+
+func (impl *Impl) insertPending() {
+	for _, p := range impl.drain() {
+		impl.tree.Insert(p.elemt.([]float64), p.weight)
+	}
+}
+
+// entries returns the centroids and weights of leaf entries
+func (impl *Impl) entries() (centroids []core.Elemt, weights []int) {
+	var entries = impl.tree.Entries()
+	centroids = make([]core.Elemt, len(entries))
+	weights = make([]int, len(entries))
+	for i := range entries {
+		centroids[i], weights[i] = entries[i].Centroid(), entries[i].N
+	}
+	return
+}
+
+// reduceStats returns cluster statistics, leaf entries being assigned to their nearest centroid.
+// Losses are exact sums of squared distances, radii are bounded by entry distances plus entry radii.
+func (impl *Impl) reduceStats(clust core.Clust, space core.Space) (stats []core.ClusterStats) {
+	stats = make([]core.ClusterStats, len(clust))
+	for _, entry := range impl.tree.Entries() {
+		var _, label, dist = clust.Assign(entry.Centroid(), space)
+		stats[label].Size += entry.N
+		stats[label].Loss += entry.SSE(clust[label].([]float64))
+		if radius := dist + entry.Radius(); radius > stats[label].Radius {
+			stats[label].Radius = radius
+		}
+	}
+	return
+}
+
+// Tree returns the clustering feature tree
+func (impl *Impl) Tree() *Tree {
+	return impl.tree
+}
+
+// Stats returns cluster statistics of the last iteration
+func (impl *Impl) Stats() []core.ClusterStats {
+	return impl.stats
+}
+
+// Push input element
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
+	return impl.PushWeighted(elemt, 1, model)
+}
+
+// PushWeighted input weighted element
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) (err error) {
+	select {
+	case impl.c <- weightedElemt{elemt, weight}:
+	default:
+		err = errors.New("buffer is full")
+	}
+	return
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, impl.initializer, nil)
+	for _, entry := range impl.tree.Entries() {
+		newImpl.tree.InsertFeature(entry)
+	}
+	return &newImpl, nil
+}
+
+// implState is the persisted form of an Impl
+type implState struct {
+	Threshold float64
+	Entries   []Feature
+	Pending   []core.Elemt
+	Weights   []int
+}
+
+// Save writes leaf entries, the tree threshold and pending elements.
+// Pending elements remain pending.
+func (impl *Impl) Save(w io.Writer) (err error) {
+	var pending = impl.drain()
+	var state = implState{
+		Threshold: impl.tree.Threshold(),
+		Entries:   impl.tree.Entries(),
+	}
+	for _, p := range pending {
+		state.Pending = append(state.Pending, p.elemt)
+		state.Weights = append(state.Weights, p.weight)
+	}
+	err = gob.NewEncoder(w).Encode(state)
+	_ = impl.enqueue(pending)
+	return
+}
+
+// Load replaces the tree and pending elements with saved ones
+func (impl *Impl) Load(r io.Reader) (err error) {
+	var state implState
+	err = gob.NewDecoder(r).Decode(&state)
+	if err == nil {
+		impl.drain()
+		impl.tree = NewTree(impl.conf.Branching, state.Threshold, impl.conf.MaxEntries)
+		for _, entry := range state.Entries {
+			impl.tree.InsertFeature(entry)
+		}
+		var pending = make([]weightedElemt, len(state.Pending))
+		for i := range pending {
+			pending[i] = weightedElemt{state.Pending[i], core.Weight(state.Weights, i)}
+		}
+		err = impl.enqueue(pending)
+	}
+	return
+}
+
+func (impl *Impl) drain() (pending []weightedElemt) {
+	for {
+		select {
+		case p := <-impl.c:
+			pending = append(pending, p)
+		default:
+			return
+		}
+	}
+}
+
+func (impl *Impl) enqueue(pending []weightedElemt) (err error) {
+	for i := 0; i < len(pending) && err == nil; i++ {
+		err = impl.PushWeighted(pending[i].elemt, pending[i].weight, nil)
+	}
+	return
+}
@@ -0,0 +1,95 @@
+package birch_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/birch"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+var init3 = core.Clust{[]float64{1, 1}, []float64{9, 9}, []float64{-9, 9}}
+
+func TestImpl_BoundedMemory(t *testing.T) {
+	var conf = birch.Conf{K: 3, MaxEntries: 30, Branching: 6, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = birch.NewAlgo(conf, space, blobs(3000), init3.Initializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var impl = algo.Impl().(*birch.Impl)
+	test.AssertTrue(t, impl.Tree().Len() <= 30)
+
+	var centroids = algo.Centroids()
+	var labels, _ = centroids.MapLabel([]core.Elemt{[]float64{0, 0}, []float64{10, 10}, []float64{-10, 10}}, space)
+	test.AssertArrayEqual(t, []int{0, 1, 2}, labels)
+	for i, expected := range []float64{0, 10, -10} {
+		test.AssertTrue(t, centroids[i].([]float64)[0]-expected < .3 && expected-centroids[i].([]float64)[0] < .3)
+	}
+
+	var stats = algo.Stats()
+	var size = 0
+	for i := range stats {
+		size += stats[i].Size
+	}
+	test.AssertEqual(t, 3000, size)
+
+	var figures = algo.RuntimeFigures()
+	test.AssertTrue(t, figures[birch.Entries] <= 30 && figures[birch.Threshold] > 0)
+}
+
+func TestImpl_Push(t *testing.T) {
+	var conf = birch.Conf{K: 3, BufferSize: 10, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = birch.NewAlgo(conf, space, test.Vectors, kmeans.GivenInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	for i := 0; i < 10; i++ {
+		test.AssertNoError(t, algo.Push([]float64{-9, -10, -8.3, -8, -7.5}))
+	}
+	test.AssertError(t, algo.Push([]float64{-9, -10, -8.3, -8, -7.5}))
+
+	test.AssertNoError(t, algo.Batch())
+	var impl = algo.Impl().(*birch.Impl)
+	test.AssertEqual(t, 18, totalWeight(impl.Tree().Entries()))
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = birch.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1}}
+	var centroids = core.Clust(test.Vectors[:3])
+	var weighted = birch.NewAlgo(conf, space, nil, centroids.Initializer)
+	var duplicated = birch.NewAlgo(conf, space, nil, centroids.Initializer)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, duplicated.Centroids(), weighted.Centroids())
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = birch.Conf{K: 3, MaxEntries: 30, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = birch.NewAlgo(conf, space, blobs(300), init3.Initializer)
+	test.AssertNoError(t, algo.Batch())
+	_ = algo.Push([]float64{20, 20})
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+	var impl = birch.NewImpl(conf, init3.Initializer, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	test.AssertNoError(t, algo.Batch())
+	test.AssertNoError(t, restored.Batch())
+	test.AssertEqual(t, 301, totalWeight(impl.Tree().Entries()))
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+}
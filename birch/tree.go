@@ -0,0 +1,177 @@
+package birch
+
+import "math"
+
+// Tree is a clustering feature tree (CF-tree) whose leaf entries summarize inserted vectors.
+// Nodes have at most branching entries and a leaf entry absorbs a vector if its radius remains below the threshold.
+// When leaf entries exceed maxEntries, the threshold is increased and the tree is rebuilt from its leaf entries,
+// so that memory is bounded.
+type Tree struct {
+	branching  int
+	threshold  float64
+	maxEntries int
+	root       *node
+	entries    int // number of leaf entries
+}
+
+// node of a CF-tree, entries of inner nodes summarize their children
+type node struct {
+	entries  []Feature
+	children []*node // nil for leaves
+}
+
+// NewTree creates an empty tree
+func NewTree(branching int, threshold float64, maxEntries int) *Tree {
+	return &Tree{
+		branching:  branching,
+		threshold:  threshold,
+		maxEntries: maxEntries,
+		root:       &node{},
+	}
+}
+
+// Threshold returns the current maximal radius of leaf entries
+func (tree *Tree) Threshold() float64 {
+	return tree.threshold
+}
+
+// Len returns the number of leaf entries
+func (tree *Tree) Len() int {
+	return tree.entries
+}
+
+// Insert a weighted vector
+func (tree *Tree) Insert(point []float64, weight int) {
+	tree.InsertFeature(NewFeature(point, weight))
+}
+
+// InsertFeature inserts summarized vectors
+func (tree *Tree) InsertFeature(feature Feature) {
+	tree.insert(feature.Copy())
+	for tree.entries > tree.maxEntries {
+		tree.rebuild()
+	}
+}
+
+func (tree *Tree) insert(feature Feature) {
+	if split := tree.insertInto(tree.root, feature); split != nil {
+		var left = tree.root
+		tree.root = &node{
+			entries:  []Feature{left.summary(), split.summary()},
+			children: []*node{left, split},
+		}
+	}
+}
+
+// insertInto inserts a feature in the subtree of a node and returns the new sibling of the node if it has been split
+func (tree *Tree) insertInto(n *node, feature Feature) (split *node) {
+	var closest = n.closest(feature)
+	if n.children == nil {
+		if closest >= 0 && mergedRadius(n.entries[closest], feature) <= tree.threshold {
+			n.entries[closest].Add(feature)
+			return nil
+		}
+		n.entries = append(n.entries, feature)
+		tree.entries++
+	} else {
+		var child = n.children[closest]
+		n.entries[closest].Add(feature)
+		if childSplit := tree.insertInto(child, feature); childSplit != nil {
+			n.entries[closest] = child.summary()
+			n.entries = append(n.entries, childSplit.summary())
+			n.children = append(n.children, childSplit)
+		}
+	}
+	if len(n.entries) > tree.branching {
+		return n.split()
+	}
+	return nil
+}
+
+// closest returns the index of the entry whose centroid is the closest to the feature one, -1 if there is none
+func (n *node) closest(feature Feature) (closest int) {
+	closest = -1
+	var min = math.Inf(1)
+	for i := range n.entries {
+		if d := dist(n.entries[i], feature); d < min {
+			closest, min = i, d
+		}
+	}
+	return
+}
+
+// summary returns the feature summarizing all entries of the node
+func (n *node) summary() (summary Feature) {
+	for i := range n.entries {
+		summary.Add(n.entries[i])
+	}
+	return
+}
+
+// split moves half of the entries to a new node, seeds being the farthest entries
+func (n *node) split() (sibling *node) {
+	var seed1, seed2, max = 0, 1, -1.
+	for i := range n.entries {
+		for j := i + 1; j < len(n.entries); j++ {
+			if d := dist(n.entries[i], n.entries[j]); d > max {
+				seed1, seed2, max = i, j, d
+			}
+		}
+	}
+	var entries, children = n.entries, n.children
+	n.entries, n.children = nil, nil
+	sibling = &node{}
+	for i := range entries {
+		var target = n
+		if i == seed2 || i != seed1 && dist(entries[i], entries[seed2]) < dist(entries[i], entries[seed1]) {
+			target = sibling
+		}
+		target.entries = append(target.entries, entries[i])
+		if children != nil {
+			target.children = append(target.children, children[i])
+		}
+	}
+	return
+}
+
+// rebuild reinserts leaf entries in a new tree with a doubled threshold,
+// or the smallest radius of a merge of two entries if the threshold is null
+func (tree *Tree) rebuild() {
+	var entries = tree.Entries()
+	var threshold = 2 * tree.threshold
+	if threshold == 0 {
+		threshold = closestMerge(entries)
+	}
+	tree.threshold = threshold
+	tree.root = &node{}
+	tree.entries = 0
+	for i := range entries {
+		tree.insert(entries[i])
+	}
+}
+
+// closestMerge returns the smallest radius of the merge of two entries
+func closestMerge(entries []Feature) float64 {
+	var min = math.Inf(1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			min = math.Min(min, mergedRadius(entries[i], entries[j]))
+		}
+	}
+	return min
+}
+
+// Entries returns the leaf entries
+func (tree *Tree) Entries() (entries []Feature) {
+	var stack = []*node{tree.root}
+	for len(stack) > 0 {
+		var n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n.children == nil {
+			entries = append(entries, n.entries...)
+		} else {
+			stack = append(stack, n.children...)
+		}
+	}
+	return
+}
@@ -0,0 +1,58 @@
+package birch_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/birch"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func totalWeight(entries []birch.Feature) (total int) {
+	for _, entry := range entries {
+		total += entry.N
+	}
+	return
+}
+
+func TestTree_Threshold(t *testing.T) {
+	var tree = birch.NewTree(4, 1, 100)
+	tree.Insert([]float64{0, 0}, 1)
+	tree.Insert([]float64{1, 0}, 1)
+	tree.Insert([]float64{10, 0}, 2)
+
+	var entries = tree.Entries()
+	test.AssertEqual(t, 2, tree.Len())
+	test.AssertEqual(t, 2, len(entries))
+	test.AssertArrayAlmostEqual(t, []float64{.5, 0}, entries[0].Centroid())
+	test.AssertEqual(t, 2, entries[1].N)
+}
+
+func TestTree_Split(t *testing.T) {
+	var tree = birch.NewTree(3, 0, 1000)
+	var data = blobs(200)
+	for i := range data {
+		tree.Insert(data[i].([]float64), i%2+1)
+	}
+
+	var entries = tree.Entries()
+	test.AssertEqual(t, 200, tree.Len())
+	test.AssertEqual(t, 200, len(entries))
+	test.AssertEqual(t, 300, totalWeight(entries))
+}
+
+func TestTree_MaxEntries(t *testing.T) {
+	var tree = birch.NewTree(5, 0, 20)
+	var data = blobs(500)
+	for i := range data {
+		tree.Insert(data[i].([]float64), 1)
+	}
+
+	var entries = tree.Entries()
+	test.AssertTrue(t, tree.Len() <= 20)
+	test.AssertEqual(t, tree.Len(), len(entries))
+	test.AssertEqual(t, 500, totalWeight(entries))
+	test.AssertTrue(t, tree.Threshold() > 0)
+	for _, entry := range entries {
+		test.AssertTrue(t, entry.Radius() <= tree.Threshold()+1e-9)
+	}
+}
@@ -0,0 +1,12 @@
+// Package bisecting provides bisecting kmeans implementation of online clustering.
+// Clusters are the leaves of a binary tree built by splitting the cluster with the highest loss with 2-means.
+package bisecting
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new bisecting kmeans algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
@@ -0,0 +1,44 @@
+package bisecting_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/bisecting"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = bisecting.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = bisecting.NewAlgo(conf, space, nil)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_RunSyncCentroids(t *testing.T) {
+	var conf = bisecting.Conf{K: 3, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = bisecting.NewAlgo(conf, space, nil)
+
+	test.DoTestRunSyncPP(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = bisecting.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000000}}
+	var algo = bisecting.NewAlgo(conf, space, nil)
+
+	test.DoTestWorkflow(t, algo)
+}
+
+func Test_Empty(t *testing.T) {
+	var conf = bisecting.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = bisecting.NewAlgo(conf, space, nil)
+
+	if err := algo.Batch(); err == nil {
+		t.Error("error expected")
+	}
+}
@@ -0,0 +1,48 @@
+package bisecting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of bisecting kmeans
+type Conf struct {
+	core.CtrlConf
+	K          int
+	Trials     int // number of 2-means runs per bisection, the one with the lowest loss being kept, 3 if 0
+	KMeansIter int // number of iterations of 2-means runs, 10 if 0
+	FrameSize  int
+	RGen       *rand.Rand
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	switch {
+	case conf.K < 1:
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	case conf.Trials < 1:
+		err = fmt.Errorf("Illegal value for Trials: %v", conf.Trials)
+	case conf.KMeansIter < 1:
+		err = fmt.Errorf("Illegal value for KMeansIter: %v", conf.KMeansIter)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.Trials == 0 {
+		conf.Trials = 3
+	}
+	if conf.KMeansIter == 0 {
+		conf.KMeansIter = 10
+	}
+}
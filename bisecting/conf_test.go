@@ -0,0 +1,34 @@
+package bisecting_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/bisecting"
+)
+
+func TestBisecting_ConfErrorK(t *testing.T) {
+	var conf = bisecting.Conf{}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestBisecting_ConfErrorTrials(t *testing.T) {
+	var conf = bisecting.Conf{K: 2, Trials: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestBisecting_Conf(t *testing.T) {
+	var conf = bisecting.Conf{K: 2}
+	var err = conf.Verify()
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if conf.Trials != 3 || conf.KMeansIter != 10 || conf.RGen == nil {
+		t.Error("default values expected")
+	}
+}
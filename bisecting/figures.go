@@ -0,0 +1,8 @@
+package bisecting
+
+const (
+	// Clusters is the number of leaves of the bisecting tree
+	Clusters = "clusters"
+	// Loss is the sum of the losses of the leaves of the bisecting tree
+	Loss = "loss"
+)
@@ -0,0 +1,134 @@
+package bisecting
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Impl algorithm implementation.
+// The tree is built again only when elements have been pushed since the last iteration.
+type Impl struct {
+	buffer   core.Buffer
+	pushed   int64 // number of buffered elements, accessed atomically
+	applied  int64 // number of buffered elements when the tree was last built
+	computed bool  // tree is up to date with buffered elements
+	mu       sync.RWMutex
+	tree     Tree
+	stats    []core.ClusterStats
+}
+
+// NewImpl creates a new bisecting kmeans implementation
+func NewImpl(conf Conf, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer: core.NewDataBuffer(data, conf.FrameSize),
+	}
+}
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	impl.applied = atomic.LoadInt64(&impl.pushed)
+	impl.computed = false
+	_ = impl.buffer.Apply()
+	clust, _, err = impl.compute(model)
+	return
+}
+
+// Iterate builds the tree again if elements have been pushed since the last iteration
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var pushed = atomic.LoadInt64(&impl.pushed)
+	if err = impl.buffer.Apply(); err != nil {
+		return
+	}
+	if pushed != impl.applied {
+		impl.applied = pushed
+		impl.computed = false
+	}
+	return impl.compute(model)
+}
+
+// compute builds the tree if needed and returns the centroids of its leaves
+func (impl *Impl) compute(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+	var space = model.Space()
+
+	var tree = impl.Tree()
+	if !impl.computed {
+		if tree, err = NewTree(data, weights, space, conf.K, conf.Trials, conf.KMeansIter, conf.RGen); err != nil {
+			return
+		}
+		impl.computed = true
+	}
+	clust = tree.Centroids()
+	var stats = clust.ReduceStats(data, weights, space, norm)
+
+	impl.mu.Lock()
+	impl.tree, impl.stats = tree, stats
+	impl.mu.Unlock()
+
+	var loss = 0.
+	for _, node := range tree.Leaves {
+		loss += tree.Nodes[node].Loss
+	}
+	runtimeFigures = core.RuntimeFigures{
+		Clusters: float64(len(tree.Leaves)),
+		Loss:     loss,
+	}
+	return
+}
+
+// Tree returns the bisecting tree of buffered elements built by the last iteration
+func (impl *Impl) Tree() Tree {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.tree
+}
+
+// Stats returns cluster statistics of the last iteration
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) (err error) {
+	if err = impl.buffer.Push(elemt, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) (err error) {
+	if err = impl.buffer.PushWeighted(elemt, weight, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	impl.computed = false
+	return impl.buffer.Load(r)
+}
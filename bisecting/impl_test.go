@@ -0,0 +1,89 @@
+package bisecting_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/bisecting"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"golang.org/x/exp/rand"
+)
+
+func newConf(k int) bisecting.Conf {
+	return bisecting.Conf{K: k, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+}
+
+func TestImpl_Tree(t *testing.T) {
+	var algo = bisecting.NewAlgo(newConf(3), space, points)
+	test.AssertNoError(t, algo.Batch())
+
+	var tree = algo.Impl().(*bisecting.Impl).Tree()
+	test.AssertEqual(t, 3, len(tree.Leaves))
+	test.AssertCentroids(t, tree.Centroids(), algo.Centroids())
+
+	var figures = algo.RuntimeFigures()
+	test.AssertEqual(t, 3., figures[bisecting.Clusters])
+	test.AssertAlmostEqual(t, 1.5, figures[bisecting.Loss])
+
+	var stats = algo.Stats()
+	test.AssertEqual(t, 3, len(stats))
+	test.AssertEqual(t, 2, stats[0].Size)
+	test.AssertAlmostEqual(t, .5, stats[0].Loss)
+}
+
+func TestImpl_Push(t *testing.T) {
+	var algo = bisecting.NewAlgo(newConf(3), space, points)
+	test.AssertNoError(t, algo.Batch())
+
+	test.AssertNoError(t, algo.Push([]float64{100}))
+	test.AssertNoError(t, algo.Batch())
+	test.AssertArrayAlmostEqual(t, []float64{5.5, 30.5, 100}, sorted(algo.Centroids()))
+
+	var c, _, _ = algo.Predict([]float64{90})
+	test.AssertArrayAlmostEqual(t, []float64{100}, c.([]float64))
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var weighted = bisecting.NewAlgo(newConf(3), space, nil)
+	var duplicated = bisecting.NewAlgo(newConf(3), space, nil)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	assertPredict(t, duplicated, weighted)
+
+	var copied, err = weighted.Copy(weighted.Conf(), space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	assertPredict(t, weighted, copied)
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = newConf(3)
+	var algo = bisecting.NewAlgo(conf, space, points)
+	test.AssertNoError(t, algo.Batch())
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+	var impl = bisecting.NewImpl(conf, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	test.AssertNoError(t, restored.Batch())
+	test.AssertArrayAlmostEqual(t, sorted(algo.Centroids()), sorted(restored.Centroids()))
+}
+
+func assertPredict(t *testing.T, expected core.OnlineClust, actual core.OnlineClust) {
+	for _, elemt := range test.Vectors {
+		var c1, _, _ = expected.Predict(elemt)
+		var c2, _, _ = actual.Predict(elemt)
+		test.AssertArrayAlmostEqual(t, c1.([]float64), c2.([]float64))
+	}
+}
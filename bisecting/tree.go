@@ -0,0 +1,140 @@
+package bisecting
+
+import (
+	"errors"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+// norm of the losses
+const norm = 2.
+
+// Node of a bisecting tree
+type Node struct {
+	Centroid    core.Elemt
+	Weight      int     // sum of element weights
+	Loss        float64 // sum of weighted squared distances between elements and the centroid
+	Parent      int     // parent node, -1 for the root
+	Left, Right int     // children nodes, -1 for leaves
+}
+
+// Tree of successive bisections. The root is the first node and leaves are the clusters.
+type Tree struct {
+	Nodes  []Node
+	Leaves []int // leaf nodes in cluster order, a split leaf being replaced by its left child and its right child being appended
+}
+
+// NewTree bisects weighted elements until k clusters are found, the leaf with the highest loss being split first.
+// Each bisection keeps the best of trials 2-means runs of iter iterations.
+// Leaves that can not be split are left as is, so that the tree may have less than k leaves.
+// Nil weights stand for unit weights.
+func NewTree(elemts []core.Elemt, weights []int, space core.Space, k int, trials int, iter int, rgen *rand.Rand) (tree Tree, err error) {
+	if len(elemts) == 0 {
+		err = errors.New("at least one element is needed")
+		return
+	}
+	var indices = make([]int, len(elemts))
+	var rootWeights = make([]int, len(elemts))
+	for i := range indices {
+		indices[i] = i
+		rootWeights[i] = core.Weight(weights, i)
+	}
+	var root, _ = core.WeightedDBA(elemts, rootWeights, space)
+	var clust = core.Clust{root}
+	var losses, cards = clust.ReduceWeightedLoss(elemts, weights, space, norm)
+	tree.Nodes = []Node{{Centroid: root, Weight: cards[0], Loss: losses[0], Parent: -1, Left: -1, Right: -1}}
+	tree.Leaves = []int{0}
+
+	var members = map[int][]int{0: indices}
+	var frozen = map[int]bool{}
+	for len(tree.Leaves) < k {
+		var leaf = tree.highest(frozen)
+		if leaf < 0 {
+			break
+		}
+		var parent = tree.Leaves[leaf]
+		var children, childMembers, ok = bisect(elemts, weights, members[parent], space, trials, iter, rgen)
+		if !ok {
+			frozen[parent] = true
+			continue
+		}
+		var left, right = len(tree.Nodes), len(tree.Nodes) + 1
+		children[0].Parent, children[1].Parent = parent, parent
+		tree.Nodes = append(tree.Nodes, children[0], children[1])
+		tree.Nodes[parent].Left, tree.Nodes[parent].Right = left, right
+		tree.Leaves[leaf] = left
+		tree.Leaves = append(tree.Leaves, right)
+		members[left], members[right] = childMembers[0], childMembers[1]
+		delete(members, parent)
+	}
+	return
+}
+
+// highest returns the position in leaves of the leaf with the highest loss that is not frozen, -1 if none
+func (tree *Tree) highest(frozen map[int]bool) (leaf int) {
+	leaf = -1
+	for i, node := range tree.Leaves {
+		if frozen[node] || tree.Nodes[node].Weight < 2 {
+			continue
+		}
+		if leaf < 0 || tree.Nodes[node].Loss > tree.Nodes[tree.Leaves[leaf]].Loss {
+			leaf = i
+		}
+	}
+	return
+}
+
+// bisect splits elements with 2-means and returns the children nodes with their elements.
+// The split fails if both children can not be given elements.
+func bisect(elemts []core.Elemt, weights []int, indices []int, space core.Space, trials int, iter int, rgen *rand.Rand) (children [2]Node, members [2][]int, ok bool) {
+	var data = make([]core.Elemt, len(indices))
+	var dataWeights = make([]int, len(indices))
+	for i, index := range indices {
+		data[i], dataWeights[i] = elemts[index], core.Weight(weights, index)
+	}
+	var best = -1.
+	for trial := 0; trial < trials; trial++ {
+		var centroids, err = kmeans.PPInitializer(2, data, space, rgen)
+		if err != nil {
+			continue
+		}
+		var strategy = kmeans.SeqStrategy{}
+		for i := 0; i < iter; i++ {
+			centroids = strategy.Iterate(space, centroids, data, dataWeights)
+		}
+		var losses, cards = centroids.ReduceWeightedLoss(data, dataWeights, space, norm)
+		if cards[0] == 0 || cards[1] == 0 || best >= 0 && losses[0]+losses[1] >= best {
+			continue
+		}
+		best, ok = losses[0]+losses[1], true
+		var labels, _ = centroids.MapLabel(data, space)
+		members = [2][]int{}
+		for i, label := range labels {
+			members[label] = append(members[label], indices[i])
+		}
+		for c := range children {
+			children[c] = Node{Centroid: centroids[c], Weight: cards[c], Loss: losses[c], Left: -1, Right: -1}
+		}
+	}
+	return
+}
+
+// Centroids returns the centroids of the leaves in cluster order
+func (tree Tree) Centroids() (clust core.Clust) {
+	clust = make(core.Clust, len(tree.Leaves))
+	for i, node := range tree.Leaves {
+		clust[i] = tree.Nodes[node].Centroid
+	}
+	return
+}
+
+// Path returns the nodes from the root to the leaf of a cluster
+func (tree Tree) Path(label int) (path []int) {
+	for node := tree.Leaves[label]; node >= 0; node = tree.Nodes[node].Parent {
+		path = append([]int{node}, path...)
+	}
+	return
+}
@@ -0,0 +1,74 @@
+package bisecting_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/wearelumenai/distclus/bisecting"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"golang.org/x/exp/rand"
+)
+
+var points = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}, []float64{11}, []float64{30}, []float64{31}}
+
+func TestNewTree(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var tree, err = bisecting.NewTree(points, nil, space, 3, 3, 10, rgen)
+	test.AssertNoError(t, err)
+
+	test.AssertEqual(t, 5, len(tree.Nodes))
+	test.AssertEqual(t, 3, len(tree.Leaves))
+	test.AssertArrayAlmostEqual(t, []float64{.5, 10.5, 30.5}, sorted(tree.Centroids()))
+
+	var root = tree.Nodes[0]
+	test.AssertEqual(t, -1, root.Parent)
+	test.AssertEqual(t, 6, root.Weight)
+	test.AssertAlmostEqual(t, 83./6, root.Centroid.([]float64)[0])
+
+	// the far cluster is split from the root, the others are split from its sibling
+	for label, node := range tree.Leaves {
+		var path = tree.Path(label)
+		test.AssertEqual(t, node, path[len(path)-1])
+		test.AssertEqual(t, 0, path[0])
+		if tree.Nodes[node].Centroid.([]float64)[0] == 30.5 {
+			test.AssertEqual(t, 2, len(path))
+		} else {
+			test.AssertEqual(t, 3, len(path))
+		}
+		test.AssertEqual(t, -1, tree.Nodes[node].Left)
+		test.AssertAlmostEqual(t, .5, tree.Nodes[node].Loss)
+	}
+}
+
+func TestNewTree_Weighted(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var tree, err = bisecting.NewTree(points, []int{3, 1, 1, 1, 1, 1}, space, 3, 3, 10, rgen)
+	test.AssertNoError(t, err)
+
+	test.AssertArrayAlmostEqual(t, []float64{.25, 10.5, 30.5}, sorted(tree.Centroids()))
+	test.AssertEqual(t, 8, tree.Nodes[0].Weight)
+}
+
+func TestNewTree_Frozen(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var same = []core.Elemt{[]float64{1}, []float64{1}, []float64{1}}
+	var tree, err = bisecting.NewTree(same, nil, space, 3, 3, 10, rgen)
+	test.AssertNoError(t, err)
+
+	test.AssertEqual(t, 1, len(tree.Leaves))
+}
+
+func TestNewTree_Empty(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var _, err = bisecting.NewTree(nil, nil, space, 3, 3, 10, rgen)
+	test.AssertError(t, err)
+}
+
+func sorted(clust core.Clust) (values []float64) {
+	for _, centroid := range clust {
+		values = append(values, centroid.([]float64)[0])
+	}
+	sort.Float64s(values)
+	return
+}
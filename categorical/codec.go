@@ -0,0 +1,128 @@
+package categorical
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+// BinaryCodec encodes categorical vectors as their length followed by varint categories
+type BinaryCodec struct{}
+
+// Encode returns the binary encoding of a categorical vector or mode
+func (BinaryCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	return AppendCategories(nil, categories(elemt)), nil
+}
+
+// Decode returns the categorical vector encoded in data
+func (BinaryCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var values []int
+	values, data, err = ReadCategories(data)
+	if err == nil && len(data) > 0 {
+		err = core.ErrDecode
+	}
+	if err == nil {
+		elemt = values
+	}
+	return
+}
+
+// AppendCategories appends the binary encoding of categories to buf
+func AppendCategories(buf []byte, values []int) []byte {
+	var header [binary.MaxVarintLen64]byte
+	var n = binary.PutUvarint(header[:], uint64(len(values)))
+	buf = append(buf, header[:n]...)
+	for _, value := range values {
+		n = binary.PutVarint(header[:], int64(value))
+		buf = append(buf, header[:n]...)
+	}
+	return buf
+}
+
+// ReadCategories reads binary encoded categories and returns remaining data
+func ReadCategories(data []byte) (values []int, rest []byte, err error) {
+	var size, n = binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return nil, data, core.ErrDecode
+	}
+	data = data[n:]
+	values = make([]int, size)
+	for i := range values {
+		var value int64
+		if value, n = binary.Varint(data); n <= 0 {
+			return nil, data, core.ErrDecode
+		}
+		values[i] = int(value)
+		data = data[n:]
+	}
+	return values, data, nil
+}
+
+// JSONCodec encodes categorical vectors as JSON arrays of integers
+type JSONCodec struct{}
+
+// Encode returns the JSON encoding of a categorical vector or mode
+func (JSONCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	return json.Marshal(categories(elemt))
+}
+
+// Decode returns the categorical vector encoded in data
+func (JSONCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var values []int
+	err = json.Unmarshal(data, &values)
+	if err == nil {
+		elemt = values
+	}
+	return
+}
+
+// toRecord returns a record or the record of the values of a prototype
+func toRecord(elemt core.Elemt) Record {
+	var numeric, categorical = fields(elemt)
+	return Record{Numeric: numeric, Categories: categories(categorical)}
+}
+
+// RecordBinaryCodec encodes records as their binary encoded numeric fields followed by their binary encoded categories
+type RecordBinaryCodec struct{}
+
+// Encode returns the binary encoding of a record or prototype
+func (RecordBinaryCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	var record = toRecord(elemt)
+	return AppendCategories(euclid.AppendPoint(nil, record.Numeric), record.Categories), nil
+}
+
+// Decode returns the record encoded in data
+func (RecordBinaryCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var record Record
+	record.Numeric, data, err = euclid.ReadPoint(data)
+	if err == nil {
+		record.Categories, data, err = ReadCategories(data)
+	}
+	if err == nil && len(data) > 0 {
+		err = core.ErrDecode
+	}
+	if err == nil {
+		elemt = record
+	}
+	return
+}
+
+// RecordJSONCodec encodes records as JSON objects with numeric and categories fields
+type RecordJSONCodec struct{}
+
+// Encode returns the JSON encoding of a record or prototype
+func (RecordJSONCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	return json.Marshal(toRecord(elemt))
+}
+
+// Decode returns the record encoded in data
+func (RecordJSONCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var record Record
+	err = json.Unmarshal(data, &record)
+	if err == nil {
+		elemt = record
+	}
+	return
+}
@@ -0,0 +1,58 @@
+package categorical_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/categorical"
+	"github.com/wearelumenai/distclus/core"
+)
+
+func assertCodec(t *testing.T, space core.Space, elemt core.Elemt, expected core.Elemt) {
+	for _, format := range []core.Format{core.Binary, core.JSON} {
+		var codec, err = core.GetCodec(space, format)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		data, err := codec.Encode(elemt)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		decoded, err := codec.Decode(data)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		if !reflect.DeepEqual(expected, decoded) {
+			t.Error("Expected", expected, "got", decoded)
+		}
+	}
+}
+
+func TestCodec(t *testing.T) {
+	var space = categorical.NewSpace()
+	assertCodec(t, space, []int{1, -200, 3}, []int{1, -200, 3})
+	assertCodec(t, space, space.Combine([]int{1, 2}, 2, []int{0, 3}, 1), []int{1, 2})
+}
+
+func TestRecordCodec(t *testing.T) {
+	var space = categorical.NewMixedSpace(categorical.MixedConf{})
+	var record = categorical.Record{Numeric: []float64{1.5, -2}, Categories: []int{4, 2}}
+	assertCodec(t, space, record, record)
+	var prototype = space.Combine(record, 2, categorical.Record{Numeric: []float64{0, 0}, Categories: []int{1, 1}}, 2)
+	assertCodec(t, space, prototype, categorical.Record{Numeric: []float64{.75, -1}, Categories: []int{1, 1}})
+}
+
+func TestCodec_Malformed(t *testing.T) {
+	var data, _ = categorical.BinaryCodec{}.Encode([]int{1, 2})
+	for _, malformed := range [][]byte{nil, data[:len(data)-1], append(data, 0)} {
+		if _, err := (categorical.BinaryCodec{}).Decode(malformed); err != core.ErrDecode {
+			t.Error("decode error expected", err)
+		}
+	}
+	if _, err := categorical.NewSpace().Codec(core.Format(-1)); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+	if _, err := categorical.NewMixedSpace(categorical.MixedConf{}).Codec(core.Format(-1)); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+}
@@ -0,0 +1,121 @@
+package categorical
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+// Record mixes numeric and categorical fields
+type Record struct {
+	Numeric    []float64 `json:"numeric"`
+	Categories []int     `json:"categories"`
+}
+
+// Prototype is the center of records, the mean of numeric fields and the mode of categorical fields
+type Prototype struct {
+	Numeric []float64
+	Mode    Mode
+}
+
+// MixedConf defines the weighting of categorical fields
+type MixedConf struct {
+	Gamma float64 // weight of a categorical mismatch relative to squared numeric differences, 1 if not positive
+}
+
+// MixedSpace for records (Record) as in the k-prototypes algorithm.
+// The distance is the square root of the squared Euclid distance of numeric fields
+// plus Gamma times the number of mismatching categorical fields.
+type MixedSpace struct {
+	gamma  float64
+	vspace euclid.Space
+}
+
+// NewMixedSpace creates a new MixedSpace
+func NewMixedSpace(conf MixedConf) MixedSpace {
+	var gamma = conf.Gamma
+	if gamma <= 0 {
+		gamma = 1
+	}
+	return MixedSpace{
+		gamma:  gamma,
+		vspace: euclid.NewSpace(),
+	}
+}
+
+// Gamma returns the weight of categorical mismatches
+func (space MixedSpace) Gamma() float64 {
+	return space.gamma
+}
+
+// fields returns numeric fields and categories or mode of a record or prototype
+func fields(elemt core.Elemt) (numeric []float64, categories interface{}) {
+	switch value := elemt.(type) {
+	case Prototype:
+		return value.Numeric, value.Mode
+	default:
+		var record = value.(Record)
+		return record.Numeric, record.Categories
+	}
+}
+
+// Dist returns the mixed distance between records or prototypes
+func (space MixedSpace) Dist(elemt1, elemt2 core.Elemt) float64 {
+	var numeric1, categorical1 = fields(elemt1)
+	var numeric2, categorical2 = fields(elemt2)
+	var d = space.vspace.PointDist(numeric1, numeric2)
+	var h = float64(Hamming(categories(categorical1), categories(categorical2)))
+	return math.Sqrt(d*d + space.gamma*h)
+}
+
+// IsMetric returns true since the mixed distance satisfies the triangle inequality
+func (space MixedSpace) IsMetric() bool {
+	return true
+}
+
+// Combine returns the weighted prototype of records or prototypes
+func (space MixedSpace) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	var numeric1, categorical1 = fields(elemt1)
+	var numeric2, categorical2 = fields(elemt2)
+	return Prototype{
+		Numeric: space.vspace.PointCombine(numeric1, weight1, numeric2, weight2),
+		Mode:    CombineModes(categorical1, weight1, categorical2, weight2),
+	}
+}
+
+// Copy creates a copy of a record or prototype
+func (space MixedSpace) Copy(elemt core.Elemt) core.Elemt {
+	switch value := elemt.(type) {
+	case Prototype:
+		return Prototype{Numeric: space.vspace.PointCopy(value.Numeric), Mode: value.Mode.Copy()}
+	default:
+		var record = value.(Record)
+		return Record{
+			Numeric:    space.vspace.PointCopy(record.Numeric),
+			Categories: NewSpace().Copy(record.Categories).([]int),
+		}
+	}
+}
+
+// Dim returns the number of numeric and categorical fields
+func (space MixedSpace) Dim(data []core.Elemt) (dim int) {
+	if len(data) > 0 {
+		var numeric, categorical = fields(data[0])
+		dim = len(numeric) + len(categories(categorical))
+	}
+	return
+}
+
+// Codec returns the record codec for the given format, prototypes being encoded as records
+func (space MixedSpace) Codec(format core.Format) (codec core.Codec, err error) {
+	switch format {
+	case core.Binary:
+		codec = RecordBinaryCodec{}
+	case core.JSON:
+		codec = RecordJSONCodec{}
+	default:
+		err = core.ErrFormat
+	}
+	return
+}
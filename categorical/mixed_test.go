@@ -0,0 +1,60 @@
+package categorical_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/categorical"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestMixedSpace_Dist(t *testing.T) {
+	var r1 = categorical.Record{Numeric: []float64{0, 0}, Categories: []int{1, 2}}
+	var r2 = categorical.Record{Numeric: []float64{3, 4}, Categories: []int{1, 3}}
+
+	test.AssertAlmostEqual(t, math.Sqrt(26), categorical.NewMixedSpace(categorical.MixedConf{}).Dist(r1, r2))
+	test.AssertAlmostEqual(t, math.Sqrt(35), categorical.NewMixedSpace(categorical.MixedConf{Gamma: 10}).Dist(r1, r2))
+	test.AssertTrue(t, core.IsMetric(categorical.NewMixedSpace(categorical.MixedConf{})))
+}
+
+func TestMixedSpace_Combine(t *testing.T) {
+	var space = categorical.NewMixedSpace(categorical.MixedConf{Gamma: 2})
+	var r1 = categorical.Record{Numeric: []float64{0}, Categories: []int{1}}
+	var r2 = categorical.Record{Numeric: []float64{3}, Categories: []int{2}}
+
+	var prototype = space.Combine(r1, 1, r2, 2).(categorical.Prototype)
+	test.AssertArrayAlmostEqual(t, []float64{2}, prototype.Numeric)
+	test.AssertArrayEqual(t, []int{2}, prototype.Mode.Values)
+	test.AssertAlmostEqual(t, math.Sqrt(4+2), space.Dist(prototype, r1))
+
+	var copied = space.Copy(prototype).(categorical.Prototype)
+	copied.Numeric[0] = 10
+	test.AssertAlmostEqual(t, 2., prototype.Numeric[0])
+	test.AssertEqual(t, 2, space.Dim([]core.Elemt{prototype}))
+}
+
+func TestMixedSpace_KPrototypes(t *testing.T) {
+	var data []core.Elemt
+	for i := 0; i < 30; i++ {
+		var group = i % 3
+		data = append(data, categorical.Record{
+			Numeric:    []float64{float64(group*10) + float64(i%4)/4, float64(i % 5)},
+			Categories: []int{group, i % 2},
+		})
+	}
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rand.New(rand.NewSource(6305689164243))}
+	var algo = kmeans.NewAlgo(conf, categorical.NewMixedSpace(categorical.MixedConf{Gamma: 4}), data, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var _, first, _ = algo.Predict(data[0])
+	for i := range data {
+		var _, label, _ = algo.Predict(data[i])
+		if (i%3 == 0) != (label == first) {
+			t.Error("Expected records of the same group in the same cluster")
+		}
+	}
+}
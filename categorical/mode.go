@@ -0,0 +1,117 @@
+// Package categorical allows to computes clusters of categorical vectors with Hamming distance (k-modes)
+// and of records mixing numeric and categorical fields (k-prototypes).
+package categorical
+
+import (
+	"encoding/gob"
+)
+
+func init() {
+	gob.Register([]int{})
+	gob.Register(Mode{})
+	gob.Register(Record{})
+	gob.Register(Prototype{})
+}
+
+// Mode is the center of categorical vectors ([]int).
+// It keeps the weighted count of each category for each field in order to be combined with other vectors.
+type Mode struct {
+	Values []int             // most frequent category of each field
+	Counts []map[int]float64 // weighted count of each category for each field
+}
+
+// NewMode returns the mode of a single categorical vector with the given weight
+func NewMode(categories []int, weight int) Mode {
+	var mode = Mode{Values: make([]int, len(categories)), Counts: make([]map[int]float64, len(categories))}
+	for i, category := range categories {
+		mode.Values[i] = category
+		mode.Counts[i] = map[int]float64{category: float64(weight)}
+	}
+	return mode
+}
+
+// toMode returns the mode of a categorical vector or mode scaled to the given weight
+func toMode(elemt interface{}, weight int) Mode {
+	switch value := elemt.(type) {
+	case Mode:
+		return value.scale(float64(weight))
+	default:
+		return NewMode(value.([]int), weight)
+	}
+}
+
+// categories returns the categories of a categorical vector or the values of a mode
+func categories(elemt interface{}) []int {
+	switch value := elemt.(type) {
+	case Mode:
+		return value.Values
+	default:
+		return value.([]int)
+	}
+}
+
+// scale returns a mode whose counts sum to weight in each field
+func (mode Mode) scale(weight float64) Mode {
+	var scaled = Mode{Values: make([]int, len(mode.Values)), Counts: make([]map[int]float64, len(mode.Counts))}
+	copy(scaled.Values, mode.Values)
+	for i, counts := range mode.Counts {
+		var total = 0.
+		for _, count := range counts {
+			total += count
+		}
+		scaled.Counts[i] = make(map[int]float64, len(counts))
+		for category, count := range counts {
+			if total > 0 {
+				scaled.Counts[i][category] = count * weight / total
+			}
+		}
+	}
+	return scaled
+}
+
+// Copy returns a deep copy of the mode
+func (mode Mode) Copy() Mode {
+	var copied = Mode{Values: make([]int, len(mode.Values)), Counts: make([]map[int]float64, len(mode.Counts))}
+	copy(copied.Values, mode.Values)
+	for i, counts := range mode.Counts {
+		copied.Counts[i] = make(map[int]float64, len(counts))
+		for category, count := range counts {
+			copied.Counts[i][category] = count
+		}
+	}
+	return copied
+}
+
+// CombineModes returns the weighted mode of two categorical vectors or modes.
+// Ties are broken in favor of the lowest category.
+func CombineModes(elemt1 interface{}, weight1 int, elemt2 interface{}, weight2 int) Mode {
+	var mode1 = toMode(elemt1, weight1)
+	var mode2 = toMode(elemt2, weight2)
+	for i, counts := range mode2.Counts {
+		for category, count := range counts {
+			mode1.Counts[i][category] += count
+		}
+		mode1.Values[i] = argmax(mode1.Counts[i])
+	}
+	return mode1
+}
+
+func argmax(counts map[int]float64) (category int) {
+	var max = -1.
+	for c, count := range counts {
+		if count > max || (count == max && c < category) {
+			category, max = c, count
+		}
+	}
+	return
+}
+
+// Hamming returns the number of fields where two categorical vectors differ
+func Hamming(categories1 []int, categories2 []int) (dist int) {
+	for i := range categories1 {
+		if categories1[i] != categories2[i] {
+			dist++
+		}
+	}
+	return
+}
@@ -0,0 +1,63 @@
+package categorical
+
+import (
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Space for categorical vectors ([]int) with Hamming distance.
+// Combining vectors results in their weighted mode (Mode), as in the k-modes algorithm.
+type Space struct{}
+
+// NewSpace creates a new Space
+func NewSpace() Space {
+	return Space{}
+}
+
+// Dist returns the Hamming distance between categorical vectors or modes
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return float64(Hamming(categories(elemt1), categories(elemt2)))
+}
+
+// IsMetric returns true since the Hamming distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
+// Combine returns the weighted mode of categorical vectors or modes
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return CombineModes(elemt1, weight1, elemt2, weight2)
+}
+
+// Copy creates a copy of a categorical vector or mode
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	switch value := elemt.(type) {
+	case Mode:
+		return value.Copy()
+	default:
+		var categories = value.([]int)
+		var copied = make([]int, len(categories))
+		copy(copied, categories)
+		return copied
+	}
+}
+
+// Dim returns the number of fields
+func (space Space) Dim(data []core.Elemt) (dim int) {
+	if len(data) > 0 {
+		dim = len(categories(data[0]))
+	}
+	return
+}
+
+// Codec returns the categorical vector codec for the given format, modes being encoded as their values
+func (space Space) Codec(format core.Format) (codec core.Codec, err error) {
+	switch format {
+	case core.Binary:
+		codec = BinaryCodec{}
+	case core.JSON:
+		codec = JSONCodec{}
+	default:
+		err = core.ErrFormat
+	}
+	return
+}
@@ -0,0 +1,84 @@
+package categorical_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/categorical"
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestHamming(t *testing.T) {
+	test.AssertEqual(t, 2, categorical.Hamming([]int{1, 2, 3}, []int{1, 0, 0}))
+	test.AssertEqual(t, 0, categorical.Hamming([]int{1, 2, 3}, []int{1, 2, 3}))
+}
+
+func TestSpace_Combine(t *testing.T) {
+	var space = categorical.NewSpace()
+	var mode = space.Combine([]int{1, 2}, 1, []int{1, 3}, 2)
+	if !reflect.DeepEqual([]int{1, 3}, mode.(categorical.Mode).Values) {
+		t.Error("Expected [1 3] got", mode)
+	}
+	mode = space.Combine(mode, 3, []int{0, 2}, 2)
+	if !reflect.DeepEqual([]int{1, 2}, mode.(categorical.Mode).Values) {
+		t.Error("Expected [1 2] got", mode)
+	}
+	test.AssertAlmostEqual(t, 3., mode.(categorical.Mode).Counts[0][1])
+	test.AssertAlmostEqual(t, 1., space.Dist(mode, []int{1, 3}))
+}
+
+func TestSpace_CombineScale(t *testing.T) {
+	var space = categorical.NewSpace()
+	var mode = categorical.NewMode([]int{4}, 3)
+	var combined = space.Combine(mode, 1, []int{5}, 2).(categorical.Mode)
+	if combined.Values[0] != 5 {
+		t.Error("Expected mode counts to be scaled to the given weight got", combined)
+	}
+}
+
+func TestSpace_Copy(t *testing.T) {
+	var space = categorical.NewSpace()
+	var mode = space.Combine([]int{1}, 1, []int{2}, 2).(categorical.Mode)
+	var copied = space.Copy(mode).(categorical.Mode)
+	copied.Counts[0][1] = 10
+	if mode.Counts[0][1] == 10 {
+		t.Error("Expected different modes")
+	}
+	var categories = []int{1, 2}
+	space.Copy(categories).([]int)[0] = 3
+	test.AssertEqual(t, 1, categories[0])
+}
+
+func TestSpace_Dim(t *testing.T) {
+	var space = categorical.NewSpace()
+	test.AssertEqual(t, 3, space.Dim([]core.Elemt{[]int{1, 2, 3}}))
+	test.AssertEqual(t, 3, space.Dim([]core.Elemt{categorical.NewMode([]int{1, 2, 3}, 1)}))
+	test.AssertTrue(t, core.IsMetric(space))
+}
+
+func customers() (data []core.Elemt) {
+	for i := 0; i < 30; i++ {
+		var group = i % 3
+		data = append(data, []int{group, group * 10, i % 2, group + 100})
+	}
+	return
+}
+
+func TestSpace_KModes(t *testing.T) {
+	var data = customers()
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rand.New(rand.NewSource(6305689164243))}
+	var algo = kmeans.NewAlgo(conf, categorical.NewSpace(), data, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var _, first, _ = algo.Predict(data[0])
+	for i := range data {
+		var _, label, dist = algo.Predict(data[i])
+		if (i%3 == 0) != (label == first) || dist > 1 {
+			t.Error("Expected records of the same group in the same cluster")
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/cosinus"
+	"github.com/wearelumenai/distclus/dtw"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/mcmc"
+	"github.com/wearelumenai/distclus/streaming"
+
+	"golang.org/x/exp/rand"
+)
+
+// buildSpace returns the space selected by options and its JSON codec
+func buildSpace(opts options) (space core.Space, codec core.Codec) {
+	switch opts.space {
+	case "cosinus":
+		space = cosinus.NewSpace()
+	case "dtw":
+		space = dtw.NewSpace(dtw.Conf{InnerSpace: euclid.NewSpace(), Window: opts.window})
+	default:
+		space = euclid.NewSpace()
+	}
+	codec, _ = core.GetCodec(space, core.JSON)
+	return
+}
+
+// buildAlgo returns the algorithm selected by options
+func buildAlgo(opts options, space core.Space, data []core.Elemt) (algo *core.Algo, err error) {
+	var ctrl = core.CtrlConf{
+		Iter:    opts.iter,
+		Timeout: opts.timeout,
+	}
+	var seed = opts.seed
+	if seed == 0 {
+		seed = uint64(time.Now().UTC().UnixNano())
+	}
+	var rgen = rand.New(rand.NewSource(seed))
+	var initializer = kmeans.CreateInitializer(opts.initializer)
+
+	switch opts.algo {
+	case "mcmc":
+		var conf = mcmc.Conf{
+			CtrlConf: ctrl,
+			Par:      opts.par,
+			InitK:    opts.k,
+			MaxK:     opts.maxK,
+			Amp:      opts.amp,
+			B:        opts.b,
+			RGen:     rgen,
+		}
+		algo = mcmc.NewAlgo(conf, space, data, initializer, buildDistrib(opts, space, data, rgen))
+	case "streaming":
+		// one element is processed per iteration, the first one at initialization
+		if len(data) < 2 {
+			err = errors.New("streaming needs at least two elements")
+			return
+		}
+		if ctrl.Iter == 0 || ctrl.Iter > len(data)-1 {
+			ctrl.Iter = len(data) - 1
+		}
+		var conf = streaming.Conf{
+			CtrlConf:   ctrl,
+			BufferSize: len(data),
+			RGen:       rgen,
+		}
+		algo = streaming.NewAlgo(conf, space, data)
+	default:
+		var conf = kmeans.Conf{
+			CtrlConf: ctrl,
+			Par:      opts.par,
+			K:        opts.k,
+			RGen:     rgen,
+		}
+		algo = kmeans.NewAlgo(conf, space, data, initializer)
+	}
+	return
+}
+
+// buildDistrib returns the mcmc alteration distribution suitable for the space
+func buildDistrib(opts options, space core.Space, data []core.Elemt, rgen *rand.Rand) mcmc.Distrib {
+	if opts.space == "dtw" {
+		return mcmc.NewDirac()
+	}
+	return mcmc.NewMultivT(mcmc.MultivTConf{Dim: space.Dim(data), RGen: rgen})
+}
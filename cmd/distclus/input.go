@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// readData reads elements in the format given by options
+func readData(in io.Reader, opts options, codec core.Codec) (data []core.Elemt, err error) {
+	if opts.inputFormat == "jsonl" {
+		data, err = readJSONLines(in, codec)
+	} else {
+		data, err = readCSV(in, opts.header, opts.space == "dtw", opts.dim)
+	}
+	return
+}
+
+// readJSONLines decodes one element per non empty line
+func readJSONLines(in io.Reader, codec core.Codec) (data []core.Elemt, err error) {
+	var scanner = bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	var line int
+	for err == nil && scanner.Scan() {
+		line++
+		var text = bytes.TrimSpace(scanner.Bytes())
+		if len(text) > 0 {
+			var elemt core.Elemt
+			elemt, err = codec.Decode(text)
+			if err == nil && len(data) > 0 {
+				err = checkDim(elemt, data[0])
+			}
+			if err == nil {
+				data = append(data, elemt)
+			} else {
+				err = fmt.Errorf("line %v: %v", line, err)
+			}
+		}
+	}
+	if err == nil {
+		err = scanner.Err()
+	}
+	return
+}
+
+// readCSV parses one element per record.
+// Vectors are records of numbers with the same length as the first record,
+// series are records of points of the given dimension whose lengths may differ.
+func readCSV(in io.Reader, header bool, series bool, dim int) (data []core.Elemt, err error) {
+	var reader = csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var record []string
+	var line int
+	if header {
+		_, err = reader.Read()
+		line++
+	}
+	for err == nil {
+		record, err = reader.Read()
+		line++
+		if err == nil {
+			var vector []float64
+			vector, err = parseRecord(record)
+			if err == nil && series {
+				var elemt [][]float64
+				elemt, err = toSeries(vector, dim)
+				data = append(data, elemt)
+			} else if err == nil {
+				if len(data) > 0 {
+					err = checkDim(vector, data[0])
+				}
+				if err == nil {
+					data = append(data, vector)
+				}
+			}
+			if err != nil {
+				err = fmt.Errorf("line %v: %v", line, err)
+			}
+		}
+	}
+	if err == io.EOF {
+		err = nil
+	}
+	return
+}
+
+// checkDim returns an error if a vector has not the same dimension as the first one.
+// Elements that are not vectors are not checked.
+func checkDim(elemt core.Elemt, first core.Elemt) (err error) {
+	var vector, ok1 = elemt.([]float64)
+	var firstVector, ok2 = first.([]float64)
+	if ok1 && ok2 && len(vector) != len(firstVector) {
+		err = fmt.Errorf("dimension %v differs from the dimension %v of the first element", len(vector), len(firstVector))
+	}
+	return
+}
+
+func parseRecord(record []string) (vector []float64, err error) {
+	vector = make([]float64, len(record))
+	for i := 0; i < len(record) && err == nil; i++ {
+		vector[i], err = strconv.ParseFloat(record[i], 64)
+	}
+	return
+}
+
+func toSeries(vector []float64, dim int) (series [][]float64, err error) {
+	if len(vector)%dim != 0 {
+		return nil, fmt.Errorf("%v values can not be split in points of dimension %v", len(vector), dim)
+	}
+	series = make([][]float64, len(vector)/dim)
+	for i := range series {
+		series[i] = vector[i*dim : (i+1)*dim]
+	}
+	return
+}
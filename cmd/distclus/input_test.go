@@ -0,0 +1,71 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dtw"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+func TestReadCSV(t *testing.T) {
+	var data, err = readCSV(strings.NewReader("x,y\n1, 2\n3,4.5\n"), true, false, 1)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	var expected = []core.Elemt{[]float64{1, 2}, []float64{3, 4.5}}
+	if !reflect.DeepEqual(data, expected) {
+		t.Error("Expected", expected, "got", data)
+	}
+
+	if _, err = readCSV(strings.NewReader("1,2\n3,a\n"), false, false, 1); err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestReadCSV_Dim(t *testing.T) {
+	var _, err = readCSV(strings.NewReader("1,2\n3,4\n5\n"), false, false, 1)
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3:") {
+		t.Error("error at line 3 expected", err)
+	}
+}
+
+func TestReadCSV_Series(t *testing.T) {
+	var data, err = readCSV(strings.NewReader("1,2,3,4\n5,6\n"), false, true, 2)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	var expected = []core.Elemt{[][]float64{{1, 2}, {3, 4}}, [][]float64{{5, 6}}}
+	if !reflect.DeepEqual(data, expected) {
+		t.Error("Expected", expected, "got", data)
+	}
+
+	if _, err = readCSV(strings.NewReader("1,2,3\n"), false, true, 2); err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestReadJSONLines(t *testing.T) {
+	var codec = dtw.JSONCodec{}
+	var data, err = readJSONLines(strings.NewReader("[[1,2],[3,4]]\n\n[[5,6]]\n"), codec)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	var expected = []core.Elemt{[][]float64{{1, 2}, {3, 4}}, [][]float64{{5, 6}}}
+	if !reflect.DeepEqual(data, expected) {
+		t.Error("Expected", expected, "got", data)
+	}
+
+	if _, err = readJSONLines(strings.NewReader("[[1,2]]\n[1,2]\n"), codec); err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestReadJSONLines_Dim(t *testing.T) {
+	var _, err = readJSONLines(strings.NewReader("[1,2]\n\n[3]\n"), euclid.JSONCodec{})
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3:") {
+		t.Error("error at line 3 expected", err)
+	}
+}
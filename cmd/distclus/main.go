@@ -0,0 +1,93 @@
+// Command distclus clusters vectors or time series read from CSV or JSON lines files.
+//
+// Usage:
+//
+//	distclus [flags] [file]
+//
+// Data are read from the given file or from the standard input.
+// Centroids are written to the standard output unless other outputs are given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+func main() {
+	var opts, err = parseOptions(os.Args[1:], os.Stderr)
+	if err == nil {
+		err = run(opts, os.Stdin, os.Stdout)
+	}
+	if err == flag.ErrHelp {
+		os.Exit(2)
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, "distclus:", err)
+		os.Exit(1)
+	}
+}
+
+// run reads data, executes the algorithm in batch mode and writes results
+func run(opts options, stdin io.Reader, stdout io.Writer) (err error) {
+	var space, codec = buildSpace(opts)
+	var data []core.Elemt
+
+	var in = stdin
+	if opts.input != "" && opts.input != "-" {
+		var file *os.File
+		if file, err = os.Open(opts.input); err != nil {
+			return
+		}
+		defer file.Close()
+		in = file
+	}
+	data, err = readData(in, opts, codec)
+	if err != nil {
+		return
+	}
+
+	var algo *core.Algo
+	if algo, err = buildAlgo(opts, space, data); err != nil {
+		return
+	}
+	if err = algo.Batch(); err != nil {
+		return
+	}
+
+	var centroids = algo.Centroids()
+	var labels, _ = centroids.ParMapLabel(data, space, runtime.NumCPU())
+	var outputs = []struct {
+		path  string
+		write func(io.Writer) error
+	}{
+		{opts.centroids, func(w io.Writer) error { return writeCentroids(w, opts.format, centroids, codec) }},
+		{opts.labels, func(w io.Writer) error { return writeLabels(w, opts.format, labels) }},
+		{opts.figures, func(w io.Writer) error { return writeFigures(w, opts.format, algo.RuntimeFigures()) }},
+	}
+	for i := 0; i < len(outputs) && err == nil; i++ {
+		err = writeOutput(outputs[i].path, stdout, outputs[i].write)
+	}
+	return
+}
+
+// writeOutput writes to the file at path, or stdout if path is "-", or nothing if path is empty
+func writeOutput(path string, stdout io.Writer, write func(io.Writer) error) (err error) {
+	switch path {
+	case "":
+	case "-":
+		err = write(stdout)
+	default:
+		var file *os.File
+		if file, err = os.Create(path); err == nil {
+			err = write(file)
+			if errClose := file.Close(); err == nil {
+				err = errClose
+			}
+		}
+	}
+	return
+}
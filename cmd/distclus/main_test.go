@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+const vectors = `7.2,6,8,11,10
+-8,-10.5,-7,-8.5,-9
+42,41.2,42,40.2,45
+9,8,7,7.5,10
+7.2,6,8,11,10
+-9,-10,-8,-8,-7.5
+42,41.2,42.2,40.2,45
+50,51.2,49,40,45.2
+`
+
+func TestRun(t *testing.T) {
+	for _, algo := range algos {
+		for _, space := range []string{"euclid", "cosinus"} {
+			var args = []string{"-algo", algo, "-space", space, "-seed", "6305689164243", "-format", "json", "-labels", "-", "-k", "3"}
+			var opts, err = parseOptions(args, ioutil.Discard)
+			if err != nil {
+				t.Error("no error expected", err)
+			}
+			var out bytes.Buffer
+			err = run(opts, strings.NewReader(vectors), &out)
+			if err != nil {
+				t.Error(algo, space, "no error expected", err)
+				continue
+			}
+			var decoder = json.NewDecoder(&out)
+			var centroids [][]float64
+			var labels []int
+			if err = decoder.Decode(&centroids); err != nil || len(centroids) == 0 {
+				t.Error(algo, space, "centroids expected", err)
+			}
+			if err = decoder.Decode(&labels); err != nil || len(labels) != 8 {
+				t.Error(algo, space, "labels expected", err)
+			}
+		}
+	}
+}
+
+func TestRun_StreamingSingle(t *testing.T) {
+	var opts, _ = parseOptions([]string{"-algo", "streaming"}, ioutil.Discard)
+	var err = run(opts, strings.NewReader("1,2\n"), ioutil.Discard)
+	if err == nil || !strings.Contains(err.Error(), "at least two elements") {
+		t.Error("streaming error expected", err)
+	}
+}
+
+func TestRun_Series(t *testing.T) {
+	var opts, _ = parseOptions([]string{"-space", "dtw", "-dim", "2", "-k", "2", "-init", "given"}, ioutil.Discard)
+	var out bytes.Buffer
+	var err = run(opts, strings.NewReader("1,2,3,4\n9,9,9,8\n"), &out)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if out.String() != "1,2,3,4\n9,9,9,8\n" {
+		t.Error("unexpected output", out.String())
+	}
+}
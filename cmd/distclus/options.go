@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+// options are the command line parameters
+type options struct {
+	input       string
+	inputFormat string
+	header      bool
+	dim         int
+	format      string
+	centroids   string
+	labels      string
+	figures     string
+	algo        string
+	space       string
+	window      int
+	initializer string
+	k           int
+	maxK        int
+	amp         float64
+	b           float64
+	par         bool
+	seed        uint64
+	iter        int
+	timeout     time.Duration
+}
+
+var algos = []string{"kmeans", "mcmc", "streaming"}
+var spaces = []string{"euclid", "cosinus", "dtw"}
+var inputFormats = []string{"csv", "jsonl"}
+var outputFormats = []string{"csv", "json"}
+
+// parseOptions reads options from command line arguments
+func parseOptions(args []string, stderr io.Writer) (opts options, err error) {
+	var flags = flag.NewFlagSet("distclus", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&opts.inputFormat, "input-format", "", "input format: csv or jsonl. Default is guessed from the file extension, csv otherwise")
+	flags.BoolVar(&opts.header, "header", false, "skip the first line of csv input")
+	flags.IntVar(&opts.dim, "dim", 1, "dimension of time series points in csv input")
+	flags.StringVar(&opts.format, "format", "csv", "output format: csv or json")
+	flags.StringVar(&opts.centroids, "centroids", "-", "centroids output file, - for standard output, empty for none")
+	flags.StringVar(&opts.labels, "labels", "", "labels output file, - for standard output, empty for none")
+	flags.StringVar(&opts.figures, "figures", "", "runtime figures output file, - for standard output, empty for none")
+	flags.StringVar(&opts.algo, "algo", "kmeans", "algorithm: kmeans, mcmc or streaming")
+	flags.StringVar(&opts.space, "space", "euclid", "space: euclid, cosinus or dtw")
+	flags.IntVar(&opts.window, "window", 0, "dtw window, 0 for none")
+	flags.StringVar(&opts.initializer, "init", "pp", "kmeans and mcmc initializer: given, pp or rand")
+	flags.IntVar(&opts.k, "k", 3, "kmeans number of clusters, mcmc initial number of clusters")
+	flags.IntVar(&opts.maxK, "maxk", 16, "mcmc maximal number of clusters")
+	flags.Float64Var(&opts.amp, "amp", 1, "mcmc amplitude of the accept ratio")
+	flags.Float64Var(&opts.b, "b", 1, "mcmc b parameter of the accept ratio")
+	flags.BoolVar(&opts.par, "par", false, "run kmeans and mcmc in parallel")
+	flags.Uint64Var(&opts.seed, "seed", 0, "random seed, 0 for a time based seed")
+	flags.IntVar(&opts.iter, "iter", 20, "number of iterations. Streaming processes one element per iteration and at most all elements")
+	flags.DurationVar(&opts.timeout, "timeout", 0, "maximal execution duration, 0 for none")
+
+	if err = flags.Parse(args); err != nil {
+		return
+	}
+	switch flags.NArg() {
+	case 0:
+		opts.input = "-"
+	case 1:
+		opts.input = flags.Arg(0)
+	default:
+		err = errors.New("at most one input file expected")
+	}
+	if err == nil && opts.inputFormat == "" {
+		opts.inputFormat = guessFormat(opts.input)
+	}
+	if err == nil {
+		err = opts.verify()
+	}
+	return
+}
+
+// verify that option values are known
+func (opts *options) verify() (err error) {
+	var checks = []struct {
+		name   string
+		value  string
+		values []string
+	}{
+		{"algo", opts.algo, algos},
+		{"space", opts.space, spaces},
+		{"input-format", opts.inputFormat, inputFormats},
+		{"format", opts.format, outputFormats},
+	}
+	for i := 0; i < len(checks) && err == nil; i++ {
+		err = checkValue(checks[i].name, checks[i].value, checks[i].values)
+	}
+	if err == nil && kmeans.CreateInitializer(opts.initializer) == nil {
+		err = fmt.Errorf("unknown init: %v", opts.initializer)
+	}
+	if err == nil && opts.dim < 1 {
+		err = fmt.Errorf("illegal value for dim: %v", opts.dim)
+	}
+	return
+}
+
+func checkValue(name string, value string, values []string) (err error) {
+	for _, known := range values {
+		if value == known {
+			return
+		}
+	}
+	return fmt.Errorf("unknown %v: %v. Expected one of %v", name, value, strings.Join(values, ", "))
+}
+
+func guessFormat(input string) string {
+	switch strings.ToLower(filepath.Ext(input)) {
+	case ".jsonl", ".ndjson", ".json":
+		return "jsonl"
+	default:
+		return "csv"
+	}
+}
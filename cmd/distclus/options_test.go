@@ -0,0 +1,45 @@
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestParseOptions(t *testing.T) {
+	var opts, err = parseOptions([]string{"-algo", "mcmc", "-k", "2", "data.jsonl"}, ioutil.Discard)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if opts.algo != "mcmc" || opts.k != 2 || opts.input != "data.jsonl" {
+		t.Error("unexpected options", opts)
+	}
+	if opts.inputFormat != "jsonl" {
+		t.Error("Expected jsonl format got", opts.inputFormat)
+	}
+
+	opts, err = parseOptions(nil, ioutil.Discard)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if opts.input != "-" || opts.inputFormat != "csv" || opts.centroids != "-" {
+		t.Error("unexpected default options", opts)
+	}
+}
+
+func TestParseOptions_Errors(t *testing.T) {
+	var cases = [][]string{
+		{"-algo", "dbscan"},
+		{"-space", "hamming"},
+		{"-format", "xml"},
+		{"-input-format", "xml"},
+		{"-init", "foo"},
+		{"-dim", "0"},
+		{"a.csv", "b.csv"},
+		{"-unknown"},
+	}
+	for _, args := range cases {
+		if _, err := parseOptions(args, ioutil.Discard); err == nil {
+			t.Error("error expected for", args)
+		}
+	}
+}
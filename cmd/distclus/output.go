@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// writeCentroids writes one centroid per line in csv, or a JSON array.
+// Series are flattened in csv.
+func writeCentroids(w io.Writer, format string, centroids core.Clust, codec core.Codec) (err error) {
+	if format == "json" {
+		var data [][]byte
+		data, err = core.EncodeAll(codec, centroids)
+		var raws = make([]json.RawMessage, len(data))
+		for i := range data {
+			raws[i] = data[i]
+		}
+		if err == nil {
+			err = writeJSON(w, raws)
+		}
+		return
+	}
+	var records = make([][]string, len(centroids))
+	for i, centroid := range centroids {
+		records[i] = formatElemt(centroid)
+	}
+	return writeCSV(w, records)
+}
+
+// writeLabels writes one label per line in csv, or a JSON array
+func writeLabels(w io.Writer, format string, labels []int) error {
+	if format == "json" {
+		return writeJSON(w, labels)
+	}
+	var records = make([][]string, len(labels))
+	for i, label := range labels {
+		records[i] = []string{strconv.Itoa(label)}
+	}
+	return writeCSV(w, records)
+}
+
+// writeFigures writes name,value lines sorted by name in csv, or a JSON object
+func writeFigures(w io.Writer, format string, figures core.RuntimeFigures) error {
+	if format == "json" {
+		return writeJSON(w, figures)
+	}
+	var names = make([]string, 0, len(figures))
+	for name := range figures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var records = make([][]string, len(names))
+	for i, name := range names {
+		records[i] = []string{name, formatFloat(figures[name])}
+	}
+	return writeCSV(w, records)
+}
+
+func formatElemt(elemt core.Elemt) (record []string) {
+	switch e := elemt.(type) {
+	case []float64:
+		record = formatVector(nil, e)
+	case [][]float64:
+		for _, point := range e {
+			record = formatVector(record, point)
+		}
+	}
+	return
+}
+
+func formatVector(record []string, vector []float64) []string {
+	for _, x := range vector {
+		record = append(record, formatFloat(x))
+	}
+	return record
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+func writeCSV(w io.Writer, records [][]string) error {
+	var writer = csv.NewWriter(w)
+	return writer.WriteAll(records)
+}
+
+func writeJSON(w io.Writer, value interface{}) error {
+	return json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+func TestWriteCentroids(t *testing.T) {
+	var centroids = core.Clust{[]float64{1, 2.5}, []float64{-3, 4}}
+	var buffer bytes.Buffer
+	_ = writeCentroids(&buffer, "csv", centroids, euclid.JSONCodec{})
+	if out := buffer.String(); out != "1,2.5\n-3,4\n" {
+		t.Error("unexpected csv output", out)
+	}
+
+	buffer.Reset()
+	_ = writeCentroids(&buffer, "json", centroids, euclid.JSONCodec{})
+	if out := buffer.String(); out != "[[1,2.5],[-3,4]]\n" {
+		t.Error("unexpected json output", out)
+	}
+
+	buffer.Reset()
+	_ = writeCentroids(&buffer, "csv", core.Clust{[][]float64{{1, 2}, {3, 4}}}, nil)
+	if out := buffer.String(); out != "1,2,3,4\n" {
+		t.Error("unexpected csv output", out)
+	}
+}
+
+func TestWriteLabels(t *testing.T) {
+	var buffer bytes.Buffer
+	_ = writeLabels(&buffer, "csv", []int{0, 2})
+	if out := buffer.String(); out != "0\n2\n" {
+		t.Error("unexpected csv output", out)
+	}
+
+	buffer.Reset()
+	_ = writeLabels(&buffer, "json", []int{0, 2})
+	if out := buffer.String(); out != "[0,2]\n" {
+		t.Error("unexpected json output", out)
+	}
+}
+
+func TestWriteFigures(t *testing.T) {
+	var figures = core.RuntimeFigures{core.PushedData: 2, core.Iterations: 10}
+	var buffer bytes.Buffer
+	_ = writeFigures(&buffer, "csv", figures)
+	if out := buffer.String(); out != "iterations,10\npushedData,2\n" {
+		t.Error("unexpected csv output", out)
+	}
+}
@@ -25,6 +25,7 @@ type Algo struct {
 	ackChannel     chan bool
 	notifChannel   chan OCStatus
 	runtimeFigures RuntimeFigures
+	stats          []ClusterStats
 	newData        int
 	pushedData     int
 	iterations     int
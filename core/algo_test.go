@@ -468,3 +468,23 @@ func Test_IterToRun(t *testing.T) {
 
 	test.DoTestIterToRun(t, &algo)
 }
+
+func Test_PushWeighted(t *testing.T) {
+	var algo = core.NewAlgo(&mockConf{}, &mockImpl{}, mockSpace{})
+
+	if err := algo.PushWeighted(nil, 1); err != nil {
+		t.Error("no error expected", err)
+	}
+	if err := algo.PushWeighted(nil, 2); err != core.ErrNotWeighted {
+		t.Error("not weighted error expected", err)
+	}
+	if err := algo.PushWeighted(nil, 0); err != core.ErrWeight {
+		t.Error("weight error expected", err)
+	}
+	if algo.Impl().(*mockImpl).stoppedcount != 1 {
+		t.Error("one element pushed expected", algo.Impl().(*mockImpl).stoppedcount)
+	}
+	if algo.RuntimeFigures()[core.PushedData] != 1 {
+		t.Error("one pushed data expected", algo.RuntimeFigures())
+	}
+}
@@ -0,0 +1,154 @@
+package core
+
+import "math"
+
+// MetricSpace is implemented by spaces that tell whether their distance satisfies the triangle inequality
+type MetricSpace interface {
+	Space
+	IsMetric() bool
+}
+
+// IsMetric returns true if the space distance satisfies the triangle inequality
+func IsMetric(space Space) bool {
+	var metric, ok = space.(MetricSpace)
+	return ok && metric.IsMetric()
+}
+
+// Bounds keeps element labels and bounds of their distances to centroids across successive assignments,
+// so that most distance computations are skipped when centroids move a little (Hamerly's algorithm).
+// Elements must keep their positions between assignments, elements appended since the last assignment are
+// assigned by brute force. Reset must be called if elements are replaced.
+// In spaces that are not metric, all assignments are made by brute force.
+// The zero value is ready to use.
+type Bounds struct {
+	labels    []int
+	upper     []float64 // upper bound of the distance to the assigned centroid
+	lower     []float64 // lower bound of the distance to any other centroid
+	centroids Clust     // centroids of the last assignment
+}
+
+// Reset forgets labels and bounds
+func (b *Bounds) Reset() {
+	b.labels = nil
+	b.upper = nil
+	b.lower = nil
+	b.centroids = nil
+}
+
+// MapLabel assigns elements to their nearest centroid.
+// It returns labels and upper bounds of the distances to the centroids, which are exact for elements
+// that have been reassigned. Returned slices belong to the bounds and are modified by the next assignment.
+func (b *Bounds) MapLabel(centroids Clust, elemts []Elemt, space Space) (labels []int, dists []float64) {
+	return b.ParMapLabel(centroids, elemts, space, 1)
+}
+
+// ParMapLabel assigns elements to their nearest centroid in parallel
+func (b *Bounds) ParMapLabel(centroids Clust, elemts []Elemt, space Space, degree int) (labels []int, dists []float64) {
+	var metric = IsMetric(space) && len(centroids) > 1
+	b.prepare(centroids, elemts, space, metric)
+
+	var half []float64
+	if metric {
+		half = halfSeparations(centroids, space)
+	}
+	var process = func(start int, end int, rank int) {
+		for i := start; i < end; i++ {
+			b.assign(i, centroids, elemts[i], space, half)
+		}
+	}
+	if degree > 1 {
+		Par(process, len(elemts), degree)
+	} else {
+		process(0, len(elemts), 0)
+	}
+
+	b.centroids = make(Clust, len(centroids))
+	copy(b.centroids, centroids)
+	return b.labels, b.upper
+}
+
+// prepare bounds before an assignment: unknown bounds for new elements,
+// updated bounds given centroid moves for the others
+func (b *Bounds) prepare(centroids Clust, elemts []Elemt, space Space, metric bool) {
+	if !metric || len(elemts) < len(b.labels) || len(b.centroids) != len(centroids) {
+		b.Reset()
+	}
+	var known = len(b.labels)
+	for i := known; i < len(elemts); i++ {
+		b.labels = append(b.labels, -1)
+		b.upper = append(b.upper, math.Inf(1))
+		b.lower = append(b.lower, 0)
+	}
+	if known == 0 || !metric {
+		return
+	}
+
+	var moves = make([]float64, len(centroids))
+	var first, second = -1, 0.
+	for j := range centroids {
+		moves[j] = space.Dist(b.centroids[j], centroids[j])
+		if first < 0 || moves[j] > moves[first] {
+			if first >= 0 {
+				second = moves[first]
+			}
+			first = j
+		} else if moves[j] > second {
+			second = moves[j]
+		}
+	}
+	for i := 0; i < known; i++ {
+		var label = b.labels[i]
+		b.upper[i] += moves[label]
+		if label == first {
+			b.lower[i] -= second
+		} else {
+			b.lower[i] -= moves[first]
+		}
+	}
+}
+
+// halfSeparations returns for each centroid half the distance to its nearest other centroid
+func halfSeparations(centroids Clust, space Space) []float64 {
+	var half = make([]float64, len(centroids))
+	for j := range half {
+		half[j] = math.Inf(1)
+	}
+	for j := range centroids {
+		for l := j + 1; l < len(centroids); l++ {
+			var d = space.Dist(centroids[j], centroids[l]) / 2
+			half[j] = math.Min(half[j], d)
+			half[l] = math.Min(half[l], d)
+		}
+	}
+	return half
+}
+
+// assign an element, skipping distance computations if bounds allow it
+func (b *Bounds) assign(i int, centroids Clust, elemt Elemt, space Space, half []float64) {
+	var label = b.labels[i]
+	if half != nil && label >= 0 {
+		var bound = math.Max(half[label], b.lower[i])
+		if b.upper[i] <= bound {
+			return
+		}
+		b.upper[i] = space.Dist(elemt, centroids[label])
+		if b.upper[i] <= bound {
+			return
+		}
+	}
+
+	var nearest, second = math.Inf(1), math.Inf(1)
+	for j := range centroids {
+		var d = space.Dist(elemt, centroids[j])
+		if d < nearest {
+			second = nearest
+			nearest = d
+			label = j
+		} else if d < second {
+			second = d
+		}
+	}
+	b.labels[i] = label
+	b.upper[i] = nearest
+	b.lower[i] = second
+}
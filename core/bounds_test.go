@@ -0,0 +1,132 @@
+package core_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/cosinus"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func movingCentroids(rgen *rand.Rand, centroids core.Clust) core.Clust {
+	var moved = make(core.Clust, len(centroids))
+	for j := range centroids {
+		var c = centroids[j].([]float64)
+		var m = make([]float64, len(c))
+		for d := range c {
+			m[d] = c[d] + rgen.Float64() - .5
+		}
+		moved[j] = m
+	}
+	return moved
+}
+
+func assertBruteForce(t *testing.T, centroids core.Clust, data []core.Elemt, labels []int, dists []float64, space core.Space) {
+	var expectedLabels, expectedDists = centroids.MapLabel(data, space)
+	test.AssertArrayEqual(t, expectedLabels, labels)
+	for i := range dists {
+		if dists[i] < expectedDists[i]-1e-9 {
+			t.Error("Expected upper bound of", expectedDists[i], "got", dists[i])
+		}
+	}
+}
+
+func TestBounds_MapLabel(t *testing.T) {
+	var data, _ = weightedData()
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var centroids = core.Clust(test.Vectors[0:3])
+	var bounds core.Bounds
+
+	for iter := 0; iter < 20; iter++ {
+		var labels, dists = bounds.MapLabel(centroids, data, euclid.Space{})
+		assertBruteForce(t, centroids, data, labels, dists, euclid.Space{})
+		centroids = movingCentroids(rgen, centroids)
+	}
+}
+
+func TestBounds_ParMapLabel(t *testing.T) {
+	var data, _ = weightedData()
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var centroids = core.Clust(test.Vectors[0:3])
+	var bounds core.Bounds
+
+	for degree := 1; degree < 20; degree++ {
+		var labels, dists = bounds.ParMapLabel(centroids, data, euclid.Space{}, degree)
+		assertBruteForce(t, centroids, data, labels, dists, euclid.Space{})
+		centroids = movingCentroids(rgen, centroids)
+	}
+}
+
+func TestBounds_Append(t *testing.T) {
+	var data, _ = weightedData()
+	var centroids = core.Clust(test.Vectors[0:3])
+	var bounds core.Bounds
+
+	_, _ = bounds.MapLabel(centroids, data[:10], euclid.Space{})
+	var labels, dists = bounds.MapLabel(centroids, data, euclid.Space{})
+	assertBruteForce(t, centroids, data, labels, dists, euclid.Space{})
+
+	bounds.Reset()
+	labels, dists = bounds.MapLabel(centroids, data[:10], euclid.Space{})
+	assertBruteForce(t, centroids, data[:10], labels, dists, euclid.Space{})
+}
+
+type notMetricSpace struct {
+	euclid.Space
+}
+
+func (notMetricSpace) IsMetric() bool {
+	return false
+}
+
+func TestBounds_NotMetric(t *testing.T) {
+	var data, _ = weightedData()
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var centroids = core.Clust(test.Vectors[0:3])
+	var bounds core.Bounds
+	var space = notMetricSpace{}
+
+	if core.IsMetric(cosinus.NewSpace()) || core.IsMetric(space) || !core.IsMetric(euclid.Space{}) {
+		t.Error("Unexpected metric spaces")
+	}
+	for iter := 0; iter < 5; iter++ {
+		var labels, dists = bounds.MapLabel(centroids, data, space)
+		var expectedLabels, expectedDists = centroids.MapLabel(data, space)
+		test.AssertArrayEqual(t, expectedLabels, labels)
+		test.AssertArrayAlmostEqual(t, expectedDists, dists)
+		centroids = movingCentroids(rgen, centroids)
+	}
+}
+
+func TestClust_ReduceDBAWithBounds(t *testing.T) {
+	var data, weights = weightedData()
+	var centroids = core.Clust(test.Vectors[0:3])
+	var bounds core.Bounds
+
+	for iter := 0; iter < 5; iter++ {
+		var expected, expectedStats = centroids.ReduceDBAWithStats(data, weights, euclid.Space{}, 2.)
+		var actual, stats = centroids.ReduceDBAWithBounds(data, weights, &bounds, euclid.Space{}, 2.)
+		test.AssertCentroids(t, expected, actual)
+		for i := range stats {
+			if stats[i].Size != expectedStats[i].Size {
+				t.Error("Expected", expectedStats[i], "got", stats[i])
+			}
+		}
+		centroids = expected
+	}
+}
+
+func TestClust_ParReduceDBAWithBounds(t *testing.T) {
+	var data, weights = weightedData()
+	var centroids = core.Clust(test.Vectors[0:3])
+	var bounds core.Bounds
+
+	for degree := 1; degree < 20; degree++ {
+		var expected, _ = centroids.ParReduceDBAWithStats(data, weights, euclid.Space{}, 2., degree)
+		var actual, _ = centroids.ParReduceDBAWithBounds(data, weights, &bounds, euclid.Space{}, 2., degree)
+		test.AssertCentroids(t, expected, actual)
+		centroids = expected
+	}
+}
@@ -1,9 +1,17 @@
 package core
 
+import (
+	"encoding/gob"
+	"io"
+)
+
 // Buffer interface
 type Buffer interface {
+	Persistent
 	Push(elemt Elemt, running bool) error
+	PushWeighted(elemt Elemt, weight int, running bool) error
 	Data() []Elemt
+	Weights() []int // weights of data, in the same order
 	Apply() error
 }
 
@@ -12,50 +20,92 @@ type Buffer interface {
 // In asynchronous mode, when pushed() is called data are staged.
 // Staged data are stored when apply() is called.
 type DataBuffer struct {
-	pipe     chan Elemt
+	pipe     chan weightedElemt
 	data     []Elemt
+	weights  []int
 	strategy bufferSizeStrategy
 }
 
+// weightedElemt is a staged element
+type weightedElemt struct {
+	elemt  Elemt
+	weight int
+}
+
 // Maximal default pipe size
 const pipeSize = 2000
 
 // NewDataBuffer creates a fixed size buffer if given size > 0.
 // Otherwise creates an infinite size buffer.
 func NewDataBuffer(data []Elemt, size int) Buffer {
+	var buffer, _ = NewWeightedDataBuffer(data, nil, size)
+	return buffer
+}
+
+// NewWeightedDataBuffer creates a buffer with weighted data.
+// Nil weights stand for unit weights, ErrWeight is returned if a weight is lower than 1.
+func NewWeightedDataBuffer(data []Elemt, weights []int, size int) (buffer Buffer, err error) {
+	for _, weight := range weights {
+		if weight < 1 {
+			err = ErrWeight
+			return
+		}
+	}
 	var db = DataBuffer{
-		pipe: make(chan Elemt, pipeSize),
+		pipe: make(chan weightedElemt, pipeSize),
 	}
+	var start = 0
 
 	switch {
 	case size > len(data):
 		// fixed size buffer, less data than buffer size
 		db.strategy = &fixedSizeStrategy{size, len(data)}
 		db.data = make([]Elemt, len(data), size)
-		copy(db.data[:len(data)], data)
+		db.weights = make([]int, len(data), size)
 
 	case size > 0:
 		// fixed size buffer, more data than buffer size
 		db.strategy = &fixedSizeStrategy{size, size}
 		db.data = make([]Elemt, size)
-		copy(db.data, data[len(data)-size:])
+		db.weights = make([]int, size)
+		start = len(data) - size
 
 	default:
 		// infinite buffer
 		db.strategy = &infiniteSizeStrategy{}
 		db.data = make([]Elemt, len(data))
-		copy(db.data, data)
+		db.weights = make([]int, len(data))
+	}
+
+	copy(db.data, data[start:])
+	for i := range db.weights {
+		db.weights[i] = Weight(weights, start+i)
 	}
 
-	return &db
+	buffer = &db
+	return
+}
+
+// CopyBuffer creates a buffer of the given size with the stored weighted data of buffer.
+// Staged data are not copied.
+func CopyBuffer(buffer Buffer, size int) (Buffer, error) {
+	return NewWeightedDataBuffer(buffer.Data(), buffer.Weights(), size)
 }
 
 // Push stores or stages an element depending on synchronous / asynchronous mode.
 func (b *DataBuffer) Push(elmt Elemt, running bool) (err error) {
-	if running {
-		b.pipe <- elmt
+	return b.PushWeighted(elmt, 1, running)
+}
+
+// PushWeighted stores or stages a weighted element depending on synchronous / asynchronous mode.
+// ErrWeight is returned if the weight is lower than 1.
+func (b *DataBuffer) PushWeighted(elmt Elemt, weight int, running bool) (err error) {
+	if weight < 1 {
+		err = ErrWeight
+	} else if running {
+		b.pipe <- weightedElemt{elmt, weight}
 	} else {
-		b.data = b.strategy.push(b.data, elmt)
+		b.store(elmt, weight)
 	}
 	return
 }
@@ -65,6 +115,23 @@ func (b *DataBuffer) Data() (data []Elemt) {
 	return b.data
 }
 
+// Weights returns buffer data weights
+func (b *DataBuffer) Weights() (weights []int) {
+	return b.weights
+}
+
+// store an element at the position given by the size strategy
+func (b *DataBuffer) store(elmt Elemt, weight int) {
+	var position = b.strategy.next(len(b.data))
+	if position < len(b.data) {
+		b.data[position] = elmt
+		b.weights[position] = weight
+	} else {
+		b.data = append(b.data, elmt)
+		b.weights = append(b.weights, weight)
+	}
+}
+
 // Apply all staged data in asynchronous mode, otherwise do nothing
 func (b *DataBuffer) Apply() (err error) {
 	for b.applyNext() {
@@ -75,12 +142,12 @@ func (b *DataBuffer) Apply() (err error) {
 // Applies next staged data if available and returns true.
 // Otherwise returns false.
 func (b *DataBuffer) applyNext() (ok bool) {
-	var elmt Elemt
+	var staged weightedElemt
 
 	select {
-	case elmt, ok = <-b.pipe:
+	case staged, ok = <-b.pipe:
 		if ok {
-			b.data = b.strategy.push(b.data, elmt)
+			b.store(staged.elemt, staged.weight)
 		}
 	default:
 	}
@@ -88,9 +155,85 @@ func (b *DataBuffer) applyNext() (ok bool) {
 	return
 }
 
+// bufferState is the persisted form of a DataBuffer
+type bufferState struct {
+	Data          []Elemt
+	Weights       []int
+	Staged        []Elemt
+	StagedWeights []int
+	Size          int
+	Position      int
+}
+
+// Save writes stored and staged data with the buffer size strategy.
+// Staged data remain staged.
+func (b *DataBuffer) Save(w io.Writer) (err error) {
+	var staged = b.drain()
+	var state = bufferState{
+		Data:    b.data,
+		Weights: b.weights,
+	}
+	for _, s := range staged {
+		state.Staged = append(state.Staged, s.elemt)
+		state.StagedWeights = append(state.StagedWeights, s.weight)
+	}
+	if fixed, ok := b.strategy.(*fixedSizeStrategy); ok {
+		state.Size = fixed.size
+		state.Position = fixed.position
+	}
+	err = gob.NewEncoder(w).Encode(state)
+	b.stage(staged)
+	return
+}
+
+// Load replaces buffer content with data read from a saved buffer
+func (b *DataBuffer) Load(r io.Reader) (err error) {
+	var state bufferState
+	err = gob.NewDecoder(r).Decode(&state)
+	if err == nil {
+		b.drain()
+		var capacity = len(state.Data)
+		if state.Size > 0 {
+			b.strategy = &fixedSizeStrategy{state.Size, state.Position}
+			capacity = state.Size
+		} else {
+			b.strategy = &infiniteSizeStrategy{}
+		}
+		b.data = make([]Elemt, len(state.Data), capacity)
+		b.weights = make([]int, len(state.Data), capacity)
+		copy(b.data, state.Data)
+		for i := range b.weights {
+			b.weights[i] = Weight(state.Weights, i)
+		}
+		for i, elmt := range state.Staged {
+			b.pipe <- weightedElemt{elmt, Weight(state.StagedWeights, i)}
+		}
+	}
+	return
+}
+
+// Removes and returns all staged data
+func (b *DataBuffer) drain() (staged []weightedElemt) {
+	for {
+		select {
+		case elmt := <-b.pipe:
+			staged = append(staged, elmt)
+		default:
+			return
+		}
+	}
+}
+
+// Stages again the given data
+func (b *DataBuffer) stage(staged []weightedElemt) {
+	for _, elmt := range staged {
+		b.pipe <- elmt
+	}
+}
+
 // Handle the way data are stored, i.e. infinite or fixed size buffer.
 type bufferSizeStrategy interface {
-	push(data []Elemt, elemt Elemt) []Elemt
+	next(length int) int // position of the next element given the number of stored ones
 }
 
 // Fixed size buffer
@@ -99,26 +242,21 @@ type fixedSizeStrategy struct {
 	position int
 }
 
-func (s *fixedSizeStrategy) push(data []Elemt, elemt Elemt) []Elemt {
+func (s *fixedSizeStrategy) next(length int) (position int) {
 	if s.position == s.size {
 		s.position = 0
 	}
 
-	if s.position < len(data) {
-		data[s.position] = elemt
-	} else {
-		data = append(data, elemt)
-	}
-
+	position = s.position
 	s.position++
 
-	return data
+	return
 }
 
 // Infinite size buffer
 type infiniteSizeStrategy struct {
 }
 
-func (s *infiniteSizeStrategy) push(data []Elemt, elemt Elemt) []Elemt {
-	return append(data, elemt)
+func (s *infiniteSizeStrategy) next(length int) int {
+	return length
 }
@@ -1,6 +1,7 @@
 package core_test
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -117,3 +118,112 @@ func TestBuffer_Apply(t *testing.T) {
 		t.Error("Expected 256 got", l)
 	}
 }
+
+func TestBuffer_SaveLoad(t *testing.T) {
+	var buf = core.NewDataBuffer(nil, 3)
+	for i := 0; i < 5; i++ {
+		_ = buf.Push([]float64{float64(i)}, false)
+	}
+	_ = buf.Push([]float64{5.}, true)
+
+	var saved bytes.Buffer
+	if err := buf.Save(&saved); err != nil {
+		t.Error("no error expected", err)
+	}
+
+	var loaded = core.NewDataBuffer(nil, 0)
+	if err := loaded.Load(&saved); err != nil {
+		t.Error("no error expected", err)
+	}
+	if !reflect.DeepEqual(buf.Data(), loaded.Data()) {
+		t.Error("Expected", buf.Data(), "got", loaded.Data())
+	}
+
+	_ = buf.Apply()
+	_ = loaded.Apply()
+	_ = buf.Push([]float64{6.}, false)
+	_ = loaded.Push([]float64{6.}, false)
+	if !reflect.DeepEqual(buf.Data(), loaded.Data()) {
+		t.Error("Expected", buf.Data(), "got", loaded.Data())
+	}
+}
+
+func TestBuffer_PushWeighted(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{1.}, []float64{2.}, []float64{3.}}
+	var buf, err = core.NewWeightedDataBuffer(elemts, []int{4, 5, 6}, 2)
+	if err != nil {
+		t.Error("Expected no error got", err)
+	}
+	if !reflect.DeepEqual([]int{5, 6}, buf.Weights()) {
+		t.Error("Expected [5 6] got", buf.Weights())
+	}
+
+	_ = buf.PushWeighted([]float64{4.}, 7, false)
+	_ = buf.PushWeighted([]float64{5.}, 8, true)
+	_ = buf.Push([]float64{6.}, true)
+	_ = buf.Apply()
+
+	var expected = []core.Elemt{[]float64{6.}, []float64{5.}}
+	if !reflect.DeepEqual(expected, buf.Data()) {
+		t.Error("Expected", expected, "got", buf.Data())
+	}
+	if !reflect.DeepEqual([]int{1, 8}, buf.Weights()) {
+		t.Error("Expected [1 8] got", buf.Weights())
+	}
+}
+
+func TestCopyBuffer(t *testing.T) {
+	var buf = core.NewDataBuffer([]core.Elemt{[]float64{1.}}, 0)
+	_ = buf.PushWeighted([]float64{2.}, 3, false)
+	_ = buf.PushWeighted([]float64{3.}, 4, true)
+
+	var copied, err = core.CopyBuffer(buf, 1)
+	if err != nil {
+		t.Error("Expected no error got", err)
+	}
+	if !reflect.DeepEqual([]core.Elemt{[]float64{2.}}, copied.Data()) || !reflect.DeepEqual([]int{3}, copied.Weights()) {
+		t.Error("Expected [[2]] [3] got", copied.Data(), copied.Weights())
+	}
+}
+
+func TestBuffer_InvalidWeight(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{1.}, []float64{2.}}
+	if _, err := core.NewWeightedDataBuffer(elemts, []int{1, 0}, 0); err != core.ErrWeight {
+		t.Error("Expected", core.ErrWeight, "got", err)
+	}
+
+	var buf = core.NewDataBuffer(elemts, 0)
+	if err := buf.PushWeighted([]float64{3.}, -1, false); err != core.ErrWeight {
+		t.Error("Expected", core.ErrWeight, "got", err)
+	}
+	if err := buf.PushWeighted([]float64{3.}, 0, true); err != core.ErrWeight {
+		t.Error("Expected", core.ErrWeight, "got", err)
+	}
+	if len(buf.Data()) != 2 {
+		t.Error("Expected 2 elements got", len(buf.Data()))
+	}
+}
+
+func TestBuffer_SaveLoadWeighted(t *testing.T) {
+	var buf = core.NewDataBuffer([]core.Elemt{[]float64{1.}}, 0)
+	_ = buf.PushWeighted([]float64{2.}, 3, false)
+	_ = buf.PushWeighted([]float64{3.}, 4, true)
+
+	var saved bytes.Buffer
+	if err := buf.Save(&saved); err != nil {
+		t.Error("no error expected", err)
+	}
+	var loaded = core.NewDataBuffer(nil, 0)
+	if err := loaded.Load(&saved); err != nil {
+		t.Error("no error expected", err)
+	}
+
+	_ = buf.Apply()
+	_ = loaded.Apply()
+	if !reflect.DeepEqual([]int{1, 3, 4}, loaded.Weights()) {
+		t.Error("Expected [1 3 4] got", loaded.Weights())
+	}
+	if !reflect.DeepEqual(buf.Data(), loaded.Data()) {
+		t.Error("Expected", buf.Data(), "got", loaded.Data())
+	}
+}
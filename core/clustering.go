@@ -43,18 +43,25 @@ func (c *Clust) ParMapLabel(elemts []Elemt, space Space, degree int) (labels []i
 
 // ReduceDBA computes centroids and cardinality of each clusters for given elements.
 func (c *Clust) ReduceDBA(elemts []Elemt, space Space) (centroids Clust, cards []int) {
+	return c.ReduceWeightedDBA(elemts, nil, space)
+}
+
+// ReduceWeightedDBA computes centroids and cardinality of each clusters for given weighted elements.
+// Cardinalities are sums of weights. Nil weights stand for unit weights.
+func (c *Clust) ReduceWeightedDBA(elemts []Elemt, weights []int, space Space) (centroids Clust, cards []int) {
 	centroids = make(Clust, len(*c))
 	cards = make([]int, len(*c))
 
-	for _, elemt := range elemts {
+	for i, elemt := range elemts {
 		var _, ix, _ = c.Assign(elemt, space)
+		var weight = Weight(weights, i)
 
 		if cards[ix] == 0 {
 			centroids[ix] = space.Copy(elemt)
-			cards[ix] = 1
+			cards[ix] = weight
 		} else {
-			centroids[ix] = space.Combine(centroids[ix], cards[ix], elemt, 1)
-			cards[ix]++
+			centroids[ix] = space.Combine(centroids[ix], cards[ix], elemt, weight)
+			cards[ix] += weight
 		}
 	}
 
@@ -87,7 +94,12 @@ func (c *Clust) ParReduceDBAForLabels(elemts []Elemt, labels []int, space Space,
 
 // ParReduceDBA computes centroids and cardinality of each clusters for given elements in parallel.
 func (c *Clust) ParReduceDBA(elemts []Elemt, space Space, degree int) (Clust, []int) {
-	return parReduceDBA(*c, elemts, space, degree)
+	return parReduceDBA(*c, elemts, nil, space, degree)
+}
+
+// ParReduceWeightedDBA computes centroids and cardinality of each clusters for given weighted elements in parallel.
+func (c *Clust) ParReduceWeightedDBA(elemts []Elemt, weights []int, space Space, degree int) (Clust, []int) {
+	return parReduceDBA(*c, elemts, weights, space, degree)
 }
 
 // TotalLoss computes loss from distances between elements and their nearest centroid
@@ -102,21 +114,46 @@ func (c *Clust) ParTotalLoss(elemts []Elemt, space Space, norm float64, degree i
 	return floats.Sum(losses)
 }
 
+// WeightedTotalLoss computes loss from distances between weighted elements and their nearest centroid
+func (c *Clust) WeightedTotalLoss(elemts []Elemt, weights []int, space Space, norm float64) float64 {
+	losses, _ := c.ReduceWeightedLoss(elemts, weights, space, norm)
+	return floats.Sum(losses)
+}
+
+// ParWeightedTotalLoss computes loss from distances between weighted elements and their nearest centroid in parallel
+func (c *Clust) ParWeightedTotalLoss(elemts []Elemt, weights []int, space Space, norm float64, degree int) float64 {
+	losses, _ := c.ParReduceWeightedLoss(elemts, weights, space, norm, degree)
+	return floats.Sum(losses)
+}
+
 // ReduceLoss computes loss and cardinality in each cluster for the given elements
 func (c *Clust) ReduceLoss(elemts []Elemt, space Space, norm float64) ([]float64, []int) {
+	return c.ReduceWeightedLoss(elemts, nil, space, norm)
+}
+
+// ReduceWeightedLoss computes loss and cardinality in each cluster for the given weighted elements.
+// Each distance is multiplied by the element weight and cardinalities are sums of weights.
+// Nil weights stand for unit weights.
+func (c *Clust) ReduceWeightedLoss(elemts []Elemt, weights []int, space Space, norm float64) ([]float64, []int) {
 	var losses = make([]float64, len(*c))
 	var cards = make([]int, len(*c))
-	for _, elemt := range elemts {
+	for i, elemt := range elemts {
 		var label, min = c.nearest(elemt, space)
-		cards[label]++
-		losses[label] += math.Pow(min, norm)
+		var weight = Weight(weights, i)
+		cards[label] += weight
+		losses[label] += float64(weight) * math.Pow(min, norm)
 	}
 	return losses, cards
 }
 
 // ParReduceLoss computes loss and cardinality in each cluster for the given elements in parallel
 func (c *Clust) ParReduceLoss(elemts []Elemt, space Space, norm float64, degree int) ([]float64, []int) {
-	return parLoss(*c, elemts, space, norm, degree)
+	return parLoss(*c, elemts, nil, space, norm, degree)
+}
+
+// ParReduceWeightedLoss computes loss and cardinality in each cluster for the given weighted elements in parallel
+func (c *Clust) ParReduceWeightedLoss(elemts []Elemt, weights []int, space Space, norm float64, degree int) ([]float64, []int) {
+	return parLoss(*c, elemts, weights, space, norm, degree)
 }
 
 // ReduceLossForLabels computes loss and cardinality in each cluster for the given labels
@@ -190,6 +227,14 @@ func WeightedDBA(elemts []Elemt, weights []int, space Space) (dba Elemt, err err
 	return
 }
 
+// Weight returns the weight of the i-th element, 1 if weights are nil
+func Weight(weights []int, i int) int {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
+
 // Initializer that always returns the centroids
 func (c *Clust) Initializer(int, []Elemt, Space, *rand.Rand) (centroids Clust, err error) {
 	return *c, nil
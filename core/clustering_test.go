@@ -238,3 +238,33 @@ func TestClust_LossForLabels(t *testing.T) {
 		t.Error("loss error")
 	}
 }
+
+func TestClust_ReduceWeightedDBA(t *testing.T) {
+	var clust = core.Clust{[]float64{0.}, []float64{10.}}
+	var elemts = []core.Elemt{[]float64{1.}, []float64{4.}, []float64{9.}}
+	var sp = euclid.Space{}
+
+	var result, cards = clust.ReduceWeightedDBA(elemts, []int{2, 1, 3}, sp)
+	test.AssertCentroids(t, core.Clust{[]float64{2.}, []float64{9.}}, result)
+	test.AssertArrayEqual(t, []int{3, 3}, cards)
+
+	var duplicated = []core.Elemt{elemts[0], elemts[0], elemts[1], elemts[2], elemts[2], elemts[2]}
+	var expected, expectedCards = clust.ReduceDBA(duplicated, sp)
+	test.AssertCentroids(t, expected, result)
+	test.AssertArrayEqual(t, expectedCards, cards)
+}
+
+func TestClust_ReduceWeightedLoss(t *testing.T) {
+	var clust = core.Clust{[]float64{0.}, []float64{10.}}
+	var elemts = []core.Elemt{[]float64{1.}, []float64{4.}, []float64{9.}}
+	var sp = euclid.Space{}
+
+	var losses, cards = clust.ReduceWeightedLoss(elemts, []int{2, 1, 3}, sp, 2.)
+	test.AssertArrayAlmostEqual(t, []float64{18., 3.}, losses)
+	test.AssertArrayEqual(t, []int{3, 3}, cards)
+	test.AssertAlmostEqual(t, 21., clust.WeightedTotalLoss(elemts, []int{2, 1, 3}, sp, 2.))
+
+	losses, _ = clust.ReduceWeightedLoss(elemts, nil, sp, 2.)
+	var expected, _ = clust.ReduceLoss(elemts, sp, 2.)
+	test.AssertArrayAlmostEqual(t, expected, losses)
+}
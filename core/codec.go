@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Codec encodes and decodes elements of a space for storage or transmission
+type Codec interface {
+	Encode(elemt Elemt) ([]byte, error)
+	Decode(data []byte) (Elemt, error)
+}
+
+// CodecSpace is a space that provides codecs for its elements
+type CodecSpace interface {
+	Space
+	Codec(format Format) (Codec, error)
+}
+
+// Format of encoded elements
+type Format int
+
+// Format const values
+const (
+	Binary Format = iota // compact binary encoding
+	JSON                 // JSON encoding
+)
+
+var formatNames = []string{"binary", "json"}
+
+// String display format name
+func (format Format) String() string {
+	if format < 0 || int(format) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", int(format))
+	}
+	return formatNames[int(format)]
+}
+
+// ParseFormat returns the format with the given name
+func ParseFormat(name string) (format Format, err error) {
+	for i, formatName := range formatNames {
+		if formatName == strings.ToLower(name) {
+			return Format(i), nil
+		}
+	}
+	return format, ErrFormat
+}
+
+// GetCodec returns the codec of a space for the given format
+func GetCodec(space Space, format Format) (codec Codec, err error) {
+	if codecSpace, ok := space.(CodecSpace); ok {
+		codec, err = codecSpace.Codec(format)
+	} else {
+		err = ErrFormat
+	}
+	return
+}
+
+// EncodeAll encodes all given elements
+func EncodeAll(codec Codec, elemts []Elemt) (data [][]byte, err error) {
+	data = make([][]byte, len(elemts))
+	for i := 0; i < len(elemts) && err == nil; i++ {
+		data[i], err = codec.Encode(elemts[i])
+	}
+	return
+}
+
+// DecodeAll decodes all given elements
+func DecodeAll(codec Codec, data [][]byte) (elemts []Elemt, err error) {
+	elemts = make([]Elemt, len(data))
+	for i := 0; i < len(data) && err == nil; i++ {
+		elemts[i], err = codec.Decode(data[i])
+	}
+	return
+}
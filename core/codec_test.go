@@ -0,0 +1,50 @@
+package core_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, format := range []core.Format{core.Binary, core.JSON} {
+		var parsed, err = core.ParseFormat(format.String())
+		test.AssertNoError(t, err)
+		if parsed != format {
+			t.Error("Expected", format, "got", parsed)
+		}
+	}
+	if _, err := core.ParseFormat("JSON"); err != nil {
+		t.Error("case insensitive name expected", err)
+	}
+	if _, err := core.ParseFormat("xml"); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+}
+
+func TestFormat_String(t *testing.T) {
+	test.AssertEqual(t, "json", core.JSON.String())
+	test.AssertEqual(t, "Format(7)", core.Format(7).String())
+	test.AssertEqual(t, "Format(-1)", core.Format(-1).String())
+}
+
+func TestGetCodec(t *testing.T) {
+	if _, err := core.GetCodec(mockSpace{}, core.JSON); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+
+	var codec, err = core.GetCodec(euclid.Space{}, core.JSON)
+	test.AssertNoError(t, err)
+
+	data, err := core.EncodeAll(codec, test.Vectors)
+	test.AssertNoError(t, err)
+	elemts, err := core.DecodeAll(codec, data)
+	test.AssertNoError(t, err)
+
+	if !reflect.DeepEqual(elemts, test.Vectors) {
+		t.Error("Expected", test.Vectors, "got", elemts)
+	}
+}
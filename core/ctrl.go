@@ -13,6 +13,7 @@ type OCCtrl interface {
 	Wait(Finishing, time.Duration) error       // wait for finishing condition and maximal duration. By default, finishing is ready/idle/finished status, and duration is infinite
 	Stop() error                               // stop the algorithm
 	Push(Elemt) error                          // add element
+	PushWeighted(Elemt, int) error             // add element standing for the given number of observations
 	Predict(elemt Elemt) (Elemt, int, float64) // input elemt centroid/label with distance to closest centroid
 	Batch() error                              // batch mode (stop, play, wait then stop)
 	Copy(Conf, Space) (OnlineClust, error)     // make a copy of this algo with new configuration and space
@@ -22,27 +23,52 @@ type OCCtrl interface {
 func (algo *Algo) Push(elemt Elemt) (err error) {
 	err = algo.impl.Push(elemt, algo)
 	if err == nil {
-		algo.modelMutex.Lock()
-		algo.pushedData++
-		algo.lastDataTime = time.Now().Unix()
-		var conf = algo.conf.Ctrl()
-		if algo.Status().Value == Ready && conf.DataPerIter > 0 && conf.DataPerIter <= algo.newData {
-			algo.newData = 0
-		} else {
-			algo.newData++
-		}
-		algo.updateRuntimeFigures()
-		algo.modelMutex.Unlock()
-		// try to play if waiting
-		algo.modelMutex.RLock()
-		defer algo.modelMutex.RUnlock()
-		if algo.newData == 0 {
-			algo.Play()
-		}
+		algo.pushed()
+	}
+	return
+}
+
+// PushWeighted pushes an element standing for weight observations.
+// The implementation must implement WeightedImpl unless weight equals 1.
+func (algo *Algo) PushWeighted(elemt Elemt, weight int) (err error) {
+	var impl, ok = algo.impl.(WeightedImpl)
+	switch {
+	case weight < 1:
+		err = ErrWeight
+	case ok:
+		err = impl.PushWeighted(elemt, weight, algo)
+	case weight == 1:
+		err = algo.impl.Push(elemt, algo)
+	default:
+		err = ErrNotWeighted
+	}
+	if err == nil {
+		algo.pushed()
 	}
 	return
 }
 
+// pushed updates the model after a push and plays the algorithm if enough data are pushed
+func (algo *Algo) pushed() {
+	algo.modelMutex.Lock()
+	algo.pushedData++
+	algo.lastDataTime = time.Now().Unix()
+	var conf = algo.conf.Ctrl()
+	if algo.Status().Value == Ready && conf.DataPerIter > 0 && conf.DataPerIter <= algo.newData {
+		algo.newData = 0
+	} else {
+		algo.newData++
+	}
+	algo.updateRuntimeFigures()
+	algo.modelMutex.Unlock()
+	// try to play if waiting
+	algo.modelMutex.RLock()
+	defer algo.modelMutex.RUnlock()
+	if algo.newData == 0 {
+		algo.Play()
+	}
+}
+
 // Batch executes the algorithm in batch mode
 func (algo *Algo) Batch() (err error) {
 	algo.Stop()
@@ -72,6 +98,7 @@ func (algo *Algo) init() (err error) {
 		algo.lastDataTime = 0
 		algo.iterations = 0
 		algo.runtimeFigures = RuntimeFigures{}
+		algo.stats = nil
 		algo.updateRuntimeFigures()
 		algo.modelMutex.Unlock()
 		fallthrough
@@ -84,6 +111,9 @@ func (algo *Algo) init() (err error) {
 		var centroids Clust
 		centroids, err = algo.impl.Init(algo)
 		algo.modelMutex.Lock()
+		if err == nil {
+			algo.updateStats(centroids)
+		}
 		algo.centroids = centroids
 		algo.modelMutex.Unlock()
 		if err == nil {
@@ -321,6 +351,7 @@ func (algo *Algo) saveIterContext(centroids Clust, runtimeFigures RuntimeFigures
 		runtimeFigures = RuntimeFigures{}
 	}
 	runtimeFigures[Duration] = float64(algo.duration + duration)
+	algo.updateStats(centroids)
 	algo.centroids = centroids
 	algo.runtimeFigures = runtimeFigures
 	algo.updateRuntimeFigures()
@@ -43,3 +43,21 @@ var ErrNotIterate = errors.New("algorithm can not iterate. Check iterations and
 
 // ErrNotAlive raised when algo is not alive
 var ErrNotAlive = errors.New("algorithm is not alive")
+
+// ErrSnapshotVersion raised when a snapshot format version is not supported
+var ErrSnapshotVersion = errors.New("unsupported snapshot version")
+
+// ErrFormat raised when a codec format is not supported by a space
+var ErrFormat = errors.New("unsupported codec format")
+
+// ErrDecode raised when encoded data are malformed
+var ErrDecode = errors.New("malformed encoded element")
+
+// ErrEncode raised when the type of an element is not supported by a codec
+var ErrEncode = errors.New("element type not supported by the codec")
+
+// ErrWeight raised when an element weight is not positive
+var ErrWeight = errors.New("element weight must be positive")
+
+// ErrNotWeighted raised when weighted elements are pushed to an implementation that does not support them
+var ErrNotWeighted = errors.New("implementation does not support weighted elements")
@@ -11,3 +11,10 @@ type Impl interface {
 	// Get a copy of  impl
 	Copy(OCModel) (Impl, error)
 }
+
+// WeightedImpl is implemented by concrete algorithms that accept weighted elements
+type WeightedImpl interface {
+	Impl
+	// push a data standing for the given number of observations. The third argument is the model
+	PushWeighted(Elemt, int, OCModel) error
+}
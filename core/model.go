@@ -8,6 +8,7 @@ type OCModel interface {
 	Space() Space                   // data space
 	Status() OCStatus               // algo status
 	RuntimeFigures() RuntimeFigures // clustering figures
+	Stats() []ClusterStats          // cluster statistics if maintained by the implementation
 }
 
 // Centroids Get the centroids currently found by the algorithm
@@ -92,6 +93,11 @@ func (model SimpleOCModel) Impl() Impl {
 	return model.impl
 }
 
+// Stats for simpleocmodel
+func (model SimpleOCModel) Stats() []ClusterStats {
+	return nil
+}
+
 // NewSimpleOCModel creates a simple oc model
 func NewSimpleOCModel(conf Conf, space Space, status OCStatus, runtimeFigures RuntimeFigures, centroids Clust) OCModel {
 	return SimpleOCModel{
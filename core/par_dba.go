@@ -5,11 +5,11 @@ type dbaPartition struct {
 	cards []int
 }
 
-func parReduceDBA(centroids Clust, data []Elemt, space Space, degree int) (Clust, []int) {
+func parReduceDBA(centroids Clust, data []Elemt, weights []int, space Space, degree int) (Clust, []int) {
 	var parts = make([]dbaPartition, degree)
 
 	var process = func(start int, end int, rank int) {
-		dbaReduce(space, centroids, data[start:end], &parts[rank])
+		dbaReduce(space, centroids, data[start:end], weightsPart(weights, start, end), &parts[rank])
 	}
 
 	Par(process, len(data), degree)
@@ -36,8 +36,8 @@ func dbaReduceForLabels(space Space, centroids Clust, elemts []Elemt, labels []i
 	part.dbas, part.cards = centroids.ReduceDBAForLabels(elemts, labels, space)
 }
 
-func dbaReduce(space Space, centroids Clust, elemts []Elemt, part *dbaPartition) {
-	part.dbas, part.cards = centroids.ReduceDBA(elemts, space)
+func dbaReduce(space Space, centroids Clust, elemts []Elemt, weights []int, part *dbaPartition) {
+	part.dbas, part.cards = centroids.ReduceWeightedDBA(elemts, weights, space)
 }
 
 func dbaAggregate(parts []dbaPartition, space Space) dbaPartition {
@@ -23,3 +23,23 @@ func TestClust_ParReduceDBA(t *testing.T) {
 		test.AssertArrayEqual(t, seqCards, parCards)
 	}
 }
+
+func TestClust_ParReduceWeightedDBA(t *testing.T) {
+	var data = make([]core.Elemt, 0, len(test.Vectors)*20)
+	var weights = make([]int, 0, len(test.Vectors)*20)
+	var centroids = core.Clust(test.Vectors[0:3])
+	for i := 0; i < 20; i++ {
+		data = append(data, test.Vectors...)
+		for j := range test.Vectors {
+			weights = append(weights, i+j+1)
+		}
+	}
+
+	for degree := 1; degree < 100; degree++ {
+		var seqDbas, seqCards = centroids.ReduceWeightedDBA(data, weights, euclid.Space{})
+		var parDbas, parCards = centroids.ParReduceWeightedDBA(data, weights, euclid.Space{}, degree)
+
+		test.AssertCentroids(t, seqDbas, parDbas)
+		test.AssertArrayEqual(t, seqCards, parCards)
+	}
+}
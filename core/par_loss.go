@@ -18,11 +18,11 @@ func parLossForLabels(centroids Clust, data []Elemt, labels []int, space Space,
 	return aggr.losses, aggr.cards
 }
 
-func parLoss(centroids Clust, data []Elemt, space Space, norm float64, degree int) ([]float64, []int) {
+func parLoss(centroids Clust, data []Elemt, weights []int, space Space, norm float64, degree int) ([]float64, []int) {
 	var parts = make([]partitionLosses, degree)
 
 	var process = func(start int, end int, rank int) {
-		lossReduce(centroids, data[start:end], space, norm, &parts[rank])
+		lossReduce(centroids, data[start:end], weightsPart(weights, start, end), space, norm, &parts[rank])
 	}
 
 	Par(process, len(data), degree)
@@ -36,9 +36,9 @@ func lossReduceForLabels(centroids Clust, elemts []Elemt, labels []int, space Sp
 	part.losses, part.cards = centroids.ReduceLossForLabels(elemts, labels, space, norm)
 }
 
-func lossReduce(centroids Clust, elemts []Elemt, space Space, norm float64,
+func lossReduce(centroids Clust, elemts []Elemt, weights []int, space Space, norm float64,
 	part *partitionLosses) {
-	part.losses, part.cards = centroids.ReduceLoss(elemts, space, norm)
+	part.losses, part.cards = centroids.ReduceWeightedLoss(elemts, weights, space, norm)
 }
 
 func lossAggregate(parts []partitionLosses) partitionLosses {
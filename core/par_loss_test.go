@@ -58,3 +58,25 @@ func TestClust_ParLossForLabels(t *testing.T) {
 		test.AssertArrayEqual(t, seqCards, parCards)
 	}
 }
+
+func TestClust_ParWeightedLosses(t *testing.T) {
+	var data = make([]core.Elemt, 0, len(test.Vectors)*20)
+	var weights = make([]int, 0, len(test.Vectors)*20)
+	var centroids = core.Clust(test.Vectors[0:3])
+	for i := 0; i < 20; i++ {
+		data = append(data, test.Vectors...)
+		for j := range test.Vectors {
+			weights = append(weights, i+j+1)
+		}
+	}
+
+	for degree := 1; degree < 100; degree++ {
+		var seqLosses, seqCards = centroids.ReduceWeightedLoss(data, weights, euclid.Space{}, 2.)
+		var parLosses, parCards = centroids.ParReduceWeightedLoss(data, weights, euclid.Space{}, 2., degree)
+
+		test.AssertArrayAlmostEqual(t, seqLosses, parLosses)
+		test.AssertArrayEqual(t, seqCards, parCards)
+		test.AssertAlmostEqual(t, centroids.WeightedTotalLoss(data, weights, euclid.Space{}, 2.),
+			centroids.ParWeightedTotalLoss(data, weights, euclid.Space{}, 2., degree))
+	}
+}
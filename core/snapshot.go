@@ -0,0 +1,110 @@
+package core
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"time"
+)
+
+// SnapshotVersion is the version of the format written by Algo.Snapshot
+const SnapshotVersion = 1
+
+// Persistent is implemented by objects which state can be saved and restored.
+// Impl that implement Persistent have their state included in algorithm snapshots.
+type Persistent interface {
+	Save(io.Writer) error // write the state
+	Load(io.Reader) error // replace the state with a saved one
+}
+
+// snapshot is the persisted form of an Algo
+type snapshot struct {
+	Version        int
+	Status         ClustStatus
+	Centroids      Clust
+	RuntimeFigures RuntimeFigures
+	Stats          []ClusterStats
+	NewData        int
+	PushedData     int
+	Iterations     int
+	Duration       time.Duration
+	LastDataTime   int64
+	Impl           []byte
+}
+
+// Snapshot writes the algorithm model and the implementation state.
+// Element types must be registered with gob.Register, which is done by the spaces of this library.
+// The algorithm can not be saved while it is running.
+func (algo *Algo) Snapshot(w io.Writer) (err error) {
+	algo.statusMutex.RLock()
+	defer algo.statusMutex.RUnlock()
+	if algo.status.Value == Running {
+		return ErrRunning
+	}
+
+	algo.modelMutex.RLock()
+	var snap = snapshot{
+		Version:        SnapshotVersion,
+		Status:         algo.status.Value,
+		Centroids:      algo.centroids,
+		RuntimeFigures: algo.runtimeFigures,
+		Stats:          algo.stats,
+		NewData:        algo.newData,
+		PushedData:     algo.pushedData,
+		Iterations:     algo.iterations,
+		Duration:       algo.duration,
+		LastDataTime:   algo.lastDataTime,
+	}
+	algo.modelMutex.RUnlock()
+
+	if persistent, ok := algo.impl.(Persistent); ok {
+		var buffer bytes.Buffer
+		err = persistent.Save(&buffer)
+		snap.Impl = buffer.Bytes()
+	}
+	if err == nil {
+		err = gob.NewEncoder(w).Encode(snap)
+	}
+	return
+}
+
+// Restore creates an algorithm from a snapshot.
+// The configuration, implementation and space must be built as those of the saved algorithm.
+// If the saved algorithm was initialized, the restored one is ready to play from where it stopped.
+func Restore(r io.Reader, conf Conf, impl Impl, space Space) (algo *Algo, err error) {
+	var snap snapshot
+	err = gob.NewDecoder(r).Decode(&snap)
+	if err == nil && snap.Version != SnapshotVersion {
+		err = ErrSnapshotVersion
+	}
+	if err == nil {
+		if persistent, ok := impl.(Persistent); ok && snap.Impl != nil {
+			err = persistent.Load(bytes.NewReader(snap.Impl))
+		}
+	}
+	if err == nil {
+		algo = NewAlgo(conf, impl, space)
+		algo.restore(snap)
+	}
+	return
+}
+
+// restore the model of a new algorithm
+func (algo *Algo) restore(snap snapshot) {
+	algo.centroids = snap.Centroids
+	algo.runtimeFigures = snap.RuntimeFigures
+	if algo.runtimeFigures == nil {
+		algo.runtimeFigures = RuntimeFigures{}
+	}
+	algo.stats = snap.Stats
+	algo.newData = snap.NewData
+	algo.pushedData = snap.PushedData
+	algo.iterations = snap.Iterations
+	algo.duration = snap.Duration
+	algo.lastDataTime = snap.LastDataTime
+	if snap.Status != Created && snap.Centroids != nil {
+		algo.notifChannel = make(chan OCStatus)
+		go algo.notificationLoop()
+		algo.status = NewOCStatus(Ready)
+	}
+}
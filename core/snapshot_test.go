@@ -0,0 +1,92 @@
+package core_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func newSnapshotAlgo() *core.Algo {
+	return core.NewAlgo(
+		&mockConf{CtrlConf: core.CtrlConf{Iter: 3}},
+		&mockImpl{clust: core.Clust(test.Vectors[:3])},
+		euclid.Space{},
+	)
+}
+
+func TestAlgo_Snapshot(t *testing.T) {
+	var algo = newSnapshotAlgo()
+	var err = algo.Batch()
+	test.AssertNoError(t, err)
+
+	var buffer bytes.Buffer
+	err = algo.Snapshot(&buffer)
+	test.AssertNoError(t, err)
+
+	restored, err := core.Restore(
+		&buffer,
+		&mockConf{CtrlConf: core.CtrlConf{Iter: 3}},
+		&mockImpl{clust: core.Clust(test.Vectors[:3])},
+		euclid.Space{},
+	)
+	test.AssertNoError(t, err)
+
+	if restored.Status().Value != core.Ready {
+		t.Error("Ready expected", restored.Status())
+	}
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+
+	var figures = restored.RuntimeFigures()
+	if figures[core.Iterations] != algo.RuntimeFigures()[core.Iterations] {
+		t.Error("same iterations expected", figures)
+	}
+
+	err = restored.Play()
+	test.AssertNoError(t, err)
+	_ = restored.Stop()
+}
+
+func TestAlgo_SnapshotCreated(t *testing.T) {
+	var algo = newSnapshotAlgo()
+
+	var buffer bytes.Buffer
+	var err = algo.Snapshot(&buffer)
+	test.AssertNoError(t, err)
+
+	restored, err := core.Restore(&buffer, &mockConf{}, &mockImpl{}, euclid.Space{})
+	test.AssertNoError(t, err)
+
+	if restored.Status().Value != core.Created {
+		t.Error("Created expected", restored.Status())
+	}
+}
+
+func TestAlgo_SnapshotRunning(t *testing.T) {
+	var algo = core.NewAlgo(
+		&mockConf{},
+		&mockImpl{clust: core.Clust(test.Vectors[:3])},
+		euclid.Space{},
+	)
+	_ = algo.Play()
+	defer algo.Stop()
+
+	var buffer bytes.Buffer
+	if err := algo.Snapshot(&buffer); err != core.ErrRunning {
+		t.Error("running error expected", err)
+	}
+}
+
+func TestRestore_Version(t *testing.T) {
+	var buffer bytes.Buffer
+	var err = gob.NewEncoder(&buffer).Encode(struct{ Version int }{Version: 0})
+	test.AssertNoError(t, err)
+
+	_, err = core.Restore(&buffer, &mockConf{}, &mockImpl{}, euclid.Space{})
+	if err != core.ErrSnapshotVersion {
+		t.Error("version error expected", err)
+	}
+}
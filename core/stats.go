@@ -0,0 +1,183 @@
+package core
+
+import (
+	"math"
+	"time"
+)
+
+// ClusterStats are statistics of a cluster
+type ClusterStats struct {
+	Size       int     `json:"size"`       // number of elements, i.e. sum of their weights
+	Loss       float64 `json:"loss"`       // sum of weighted distances to the centroid at the power of the algorithm norm
+	Radius     float64 `json:"radius"`     // maximal distance between an element and the centroid
+	LastUpdate int64   `json:"lastUpdate"` // time in seconds of the last change of the cluster, set by the algorithm
+}
+
+// StatsImpl is implemented by concrete algorithms that maintain cluster statistics while iterating
+type StatsImpl interface {
+	Impl
+	// statistics of the clusters returned by the last initialization or iteration
+	Stats() []ClusterStats
+}
+
+// Stats returns the statistics of the current clusters if the implementation maintains them, nil otherwise
+func (algo *Algo) Stats() []ClusterStats {
+	algo.modelMutex.RLock()
+	defer algo.modelMutex.RUnlock()
+	return algo.stats
+}
+
+// ReduceStats computes size, loss and radius of each cluster for the given weighted elements.
+// Nil weights stand for unit weights.
+func (c *Clust) ReduceStats(elemts []Elemt, weights []int, space Space, norm float64) (stats []ClusterStats) {
+	stats = make([]ClusterStats, len(*c))
+	for i, elemt := range elemts {
+		var label, dist = c.nearest(elemt, space)
+		AddToStats(&stats[label], Weight(weights, i), dist, norm)
+	}
+	return
+}
+
+// ParReduceStats computes size, loss and radius of each cluster for the given weighted elements in parallel
+func (c *Clust) ParReduceStats(elemts []Elemt, weights []int, space Space, norm float64, degree int) []ClusterStats {
+	var parts = make([][]ClusterStats, degree)
+
+	var process = func(start int, end int, rank int) {
+		parts[rank] = c.ReduceStats(elemts[start:end], weightsPart(weights, start, end), space, norm)
+	}
+
+	Par(process, len(elemts), degree)
+
+	return statsAggregate(parts, len(*c))
+}
+
+// ReduceDBAWithStats computes centroids and statistics of each cluster for the given weighted elements in a single pass.
+// Statistics are relative to the given centroids, and centroids of empty clusters are nil.
+func (c *Clust) ReduceDBAWithStats(elemts []Elemt, weights []int, space Space, norm float64) (centroids Clust, stats []ClusterStats) {
+	centroids = make(Clust, len(*c))
+	stats = make([]ClusterStats, len(*c))
+
+	for i, elemt := range elemts {
+		var ix, dist = c.nearest(elemt, space)
+		var weight = Weight(weights, i)
+
+		if stats[ix].Size == 0 {
+			centroids[ix] = space.Copy(elemt)
+		} else {
+			centroids[ix] = space.Combine(centroids[ix], stats[ix].Size, elemt, weight)
+		}
+		AddToStats(&stats[ix], weight, dist, norm)
+	}
+
+	return
+}
+
+// ParReduceDBAWithStats computes centroids and statistics of each cluster for the given weighted elements in parallel.
+// Centroids of empty clusters are those given.
+func (c *Clust) ParReduceDBAWithStats(elemts []Elemt, weights []int, space Space, norm float64, degree int) (Clust, []ClusterStats) {
+	var reduce = func(start int, end int) (Clust, []ClusterStats) {
+		return c.ReduceDBAWithStats(elemts[start:end], weightsPart(weights, start, end), space, norm)
+	}
+	return parReduceDBAWithStats(*c, len(elemts), reduce, space, degree)
+}
+
+// ReduceDBAWithBounds computes centroids and statistics of each cluster like ReduceDBAWithStats,
+// elements being assigned with the given bounds. Losses and radii are computed from upper bounds of distances.
+func (c *Clust) ReduceDBAWithBounds(elemts []Elemt, weights []int, bounds *Bounds, space Space, norm float64) (centroids Clust, stats []ClusterStats) {
+	var labels, dists = bounds.MapLabel(*c, elemts, space)
+	return reduceDBAForLabels(len(*c), elemts, weights, labels, dists, space, norm)
+}
+
+// ParReduceDBAWithBounds computes centroids and statistics of each cluster in parallel, elements being assigned with the given bounds.
+// Centroids of empty clusters are those given.
+func (c *Clust) ParReduceDBAWithBounds(elemts []Elemt, weights []int, bounds *Bounds, space Space, norm float64, degree int) (Clust, []ClusterStats) {
+	var labels, dists = bounds.ParMapLabel(*c, elemts, space, degree)
+	var reduce = func(start int, end int) (Clust, []ClusterStats) {
+		return reduceDBAForLabels(len(*c), elemts[start:end], weightsPart(weights, start, end), labels[start:end], dists[start:end], space, norm)
+	}
+	return parReduceDBAWithStats(*c, len(elemts), reduce, space, degree)
+}
+
+func reduceDBAForLabels(k int, elemts []Elemt, weights []int, labels []int, dists []float64, space Space, norm float64) (centroids Clust, stats []ClusterStats) {
+	centroids = make(Clust, k)
+	stats = make([]ClusterStats, k)
+
+	for i, elemt := range elemts {
+		var ix = labels[i]
+		var weight = Weight(weights, i)
+
+		if stats[ix].Size == 0 {
+			centroids[ix] = space.Copy(elemt)
+		} else {
+			centroids[ix] = space.Combine(centroids[ix], stats[ix].Size, elemt, weight)
+		}
+		AddToStats(&stats[ix], weight, dists[i], norm)
+	}
+
+	return
+}
+
+func parReduceDBAWithStats(centroids Clust, size int, reduce func(int, int) (Clust, []ClusterStats), space Space, degree int) (Clust, []ClusterStats) {
+	var parts = make([]dbaPartition, degree)
+	var stats = make([][]ClusterStats, degree)
+
+	var process = func(start int, end int, rank int) {
+		parts[rank].dbas, stats[rank] = reduce(start, end)
+		parts[rank].cards = make([]int, len(stats[rank]))
+		for i := range stats[rank] {
+			parts[rank].cards[i] = stats[rank][i].Size
+		}
+	}
+
+	Par(process, size, degree)
+
+	var result, _ = buildResult(centroids, dbaAggregate(parts, space))
+	return result, statsAggregate(stats, len(centroids))
+}
+
+func statsAggregate(parts [][]ClusterStats, k int) []ClusterStats {
+	var stats = make([]ClusterStats, k)
+	for _, part := range parts {
+		for i := range part {
+			stats[i].Size += part[i].Size
+			stats[i].Loss += part[i].Loss
+			stats[i].Radius = math.Max(stats[i].Radius, part[i].Radius)
+		}
+	}
+	return stats
+}
+
+// AddToStats adds an element at the given distance from the centroid to cluster statistics
+func AddToStats(stats *ClusterStats, weight int, dist float64, norm float64) {
+	stats.Size += weight
+	stats.Loss += float64(weight) * math.Pow(dist, norm)
+	stats.Radius = math.Max(stats.Radius, dist)
+}
+
+// updateStats fetches statistics from the implementation.
+// A cluster last update time changes when its centroid or its statistics change.
+func (algo *Algo) updateStats(centroids Clust) {
+	var statsImpl, ok = algo.impl.(StatsImpl)
+	if !ok {
+		return
+	}
+	var stats = statsImpl.Stats()
+	if stats == nil {
+		algo.stats = nil
+		return
+	}
+	var now = time.Now().Unix()
+	var updated = make([]ClusterStats, len(stats))
+	for i := range stats {
+		updated[i] = stats[i]
+		updated[i].LastUpdate = now
+		if i < len(algo.stats) && i < len(algo.centroids) && i < len(centroids) {
+			var previous = algo.stats[i]
+			previous.LastUpdate = stats[i].LastUpdate
+			if previous == stats[i] && algo.space.Dist(algo.centroids[i], centroids[i]) == 0 {
+				updated[i].LastUpdate = algo.stats[i].LastUpdate
+			}
+		}
+	}
+	algo.stats = updated
+}
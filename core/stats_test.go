@@ -0,0 +1,114 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func TestClust_ReduceStats(t *testing.T) {
+	var clust = core.Clust{[]float64{0.}, []float64{10.}}
+	var elemts = []core.Elemt{[]float64{1.}, []float64{4.}, []float64{9.}}
+
+	var stats = clust.ReduceStats(elemts, []int{2, 1, 3}, euclid.Space{}, 2.)
+	var expected = []core.ClusterStats{{Size: 3, Loss: 18., Radius: 4.}, {Size: 3, Loss: 3., Radius: 1.}}
+	for i := range expected {
+		if stats[i] != expected[i] {
+			t.Error("Expected", expected[i], "got", stats[i])
+		}
+	}
+}
+
+func weightedData() (data []core.Elemt, weights []int) {
+	for i := 0; i < 20; i++ {
+		data = append(data, test.Vectors...)
+		for j := range test.Vectors {
+			weights = append(weights, i+j+1)
+		}
+	}
+	return
+}
+
+func assertStats(t *testing.T, expected []core.ClusterStats, actual []core.ClusterStats) {
+	if len(expected) != len(actual) {
+		t.Error("Expected", len(expected), "clusters got", len(actual))
+		return
+	}
+	for i := range expected {
+		if expected[i].Size != actual[i].Size || expected[i].Radius != actual[i].Radius {
+			t.Error("Expected", expected[i], "got", actual[i])
+		}
+		test.AssertAlmostEqual(t, expected[i].Loss, actual[i].Loss)
+	}
+}
+
+func TestClust_ParReduceStats(t *testing.T) {
+	var data, weights = weightedData()
+	var centroids = core.Clust(test.Vectors[0:3])
+
+	for degree := 1; degree < 100; degree++ {
+		var seqStats = centroids.ReduceStats(data, weights, euclid.Space{}, 2.)
+		var parStats = centroids.ParReduceStats(data, weights, euclid.Space{}, 2., degree)
+		assertStats(t, seqStats, parStats)
+	}
+}
+
+func TestClust_ReduceDBAWithStats(t *testing.T) {
+	var data, weights = weightedData()
+	var centroids = core.Clust(test.Vectors[0:3])
+	var space = euclid.Space{}
+
+	var dbas, stats = centroids.ReduceDBAWithStats(data, weights, space, 2.)
+	var expectedDbas, _ = centroids.ReduceWeightedDBA(data, weights, space)
+	test.AssertCentroids(t, expectedDbas, dbas)
+	assertStats(t, centroids.ReduceStats(data, weights, space, 2.), stats)
+
+	for degree := 1; degree < 100; degree++ {
+		var parDbas, parStats = centroids.ParReduceDBAWithStats(data, weights, space, 2., degree)
+		test.AssertCentroids(t, dbas, parDbas)
+		assertStats(t, stats, parStats)
+	}
+}
+
+type statsImpl struct {
+	mockImpl
+	stats []core.ClusterStats
+}
+
+func (impl *statsImpl) Iterate(model core.OCModel) (clust core.Clust, figures core.RuntimeFigures, err error) {
+	clust, figures, err = impl.mockImpl.Iterate(model)
+	if impl.iter == 1 {
+		impl.stats = []core.ClusterStats{{Size: 1}, {Size: 2}, {Size: 3}}
+	} else {
+		time.Sleep(time.Second)
+		impl.stats = []core.ClusterStats{{Size: 1}, {Size: 4}, {Size: 3}}
+	}
+	return
+}
+
+func (impl *statsImpl) Stats() []core.ClusterStats {
+	return impl.stats
+}
+
+func TestAlgo_Stats(t *testing.T) {
+	var impl = &statsImpl{mockImpl: mockImpl{clust: core.Clust(test.Vectors[:3])}}
+	var algo = core.NewAlgo(&mockConf{CtrlConf: core.CtrlConf{Iter: 2}}, impl, euclid.Space{})
+	test.AssertNoError(t, algo.Init())
+	if algo.Stats() != nil {
+		t.Error("no statistics expected", algo.Stats())
+	}
+
+	test.AssertNoError(t, algo.Batch())
+	var stats = algo.Stats()
+	for i, size := range []int{1, 4, 3} {
+		if stats[i].Size != size || stats[i].LastUpdate == 0 {
+			t.Error("Expected size", size, "and update time got", stats[i])
+		}
+	}
+	if stats[0].LastUpdate >= stats[1].LastUpdate || stats[2].LastUpdate != stats[0].LastUpdate {
+		t.Error("only the second cluster is expected to be updated", stats)
+	}
+}
@@ -29,3 +29,11 @@ func Par(process func(int, int, int), size int, degree int) {
 	}
 	wg.Wait()
 }
+
+// weightsPart returns the weights of a data partition, nil if weights are nil
+func weightsPart(weights []int, start, end int) []int {
+	if weights == nil {
+		return nil
+	}
+	return weights[start:end]
+}
@@ -75,3 +75,8 @@ func ScalarProduct(v1, v2 []float64) (product float64) {
 	}
 	return
 }
+
+// Codec returns the vector codec for the given format
+func (space Space) Codec(format core.Format) (core.Codec, error) {
+	return space.vspace.Codec(format)
+}
@@ -72,3 +72,15 @@ func TestSpace_Copy(t *testing.T) {
 		t.Error("result should be [1., 1.] got", v2)
 	}
 }
+
+func TestCodec(t *testing.T) {
+	var space = cosinus.NewSpace()
+	var codec, err = space.Codec(core.JSON)
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	var data, _ = codec.Encode([]float64{1, 2})
+	if string(data) != "[1,2]" {
+		t.Error("Expected [1,2] got", string(data))
+	}
+}
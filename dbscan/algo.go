@@ -0,0 +1,12 @@
+// Package dbscan provides density based implementation of online clustering (DBSCAN and HDBSCAN).
+// Clusters are represented by the average of their elements, elements of low density areas being labeled as noise.
+package dbscan
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new dbscan algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
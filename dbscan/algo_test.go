@@ -0,0 +1,51 @@
+package dbscan_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dbscan"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var space = euclid.Space{}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1, MinPts: 2, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, nil)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_RunSyncCentroids(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 20, MinPts: 2, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, nil)
+
+	test.DoTestRunSyncPP(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
+
+func Test_HDBSCANRunSyncCentroids(t *testing.T) {
+	var conf = dbscan.Conf{HDBSCAN: true, MinPts: 2, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, nil)
+
+	test.DoTestRunSyncPP(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 20, MinPts: 1, CtrlConf: core.CtrlConf{Iter: 1000}}
+	var algo = dbscan.NewAlgo(conf, space, nil)
+
+	test.DoTestWorkflow(t, algo)
+}
+
+func Test_Empty(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, nil)
+
+	if err := algo.Batch(); err == nil {
+		t.Error("error expected")
+	}
+}
@@ -0,0 +1,40 @@
+package dbscan
+
+import (
+	"fmt"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Conf of DBSCAN
+type Conf struct {
+	core.CtrlConf
+	Eps            float64 // radius of neighborhoods, not used by HDBSCAN
+	MinPts         int     // minimal weight of a neighborhood for its center to be a core element, 4 if 0
+	HDBSCAN        bool    // use HDBSCAN which does not need Eps
+	MinClusterSize int     // minimal weight of an HDBSCAN cluster, MinPts if 0
+	FrameSize      int
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	if conf.MinPts < 1 {
+		err = fmt.Errorf("Illegal value for MinPts: %v", conf.MinPts)
+	} else if !conf.HDBSCAN && conf.Eps <= 0 {
+		err = fmt.Errorf("Illegal value for Eps: %v", conf.Eps)
+	} else if conf.MinClusterSize < 1 {
+		err = fmt.Errorf("Illegal value for MinClusterSize: %v", conf.MinClusterSize)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.MinPts == 0 {
+		conf.MinPts = 4
+	}
+	if conf.MinClusterSize == 0 {
+		conf.MinClusterSize = conf.MinPts
+	}
+}
@@ -0,0 +1,47 @@
+package dbscan_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/dbscan"
+)
+
+func TestDBSCAN_ConfDefault(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1}
+	var err = conf.Verify()
+	if err != nil || conf.MinPts != 4 || conf.MinClusterSize != 4 {
+		t.Error("default values expected", err, conf)
+	}
+}
+
+func TestDBSCAN_ConfErrorEps(t *testing.T) {
+	var conf = dbscan.Conf{}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestDBSCAN_ConfHDBSCAN(t *testing.T) {
+	var conf = dbscan.Conf{HDBSCAN: true, MinPts: 3}
+	var err = conf.Verify()
+	if err != nil || conf.MinClusterSize != 3 {
+		t.Error("no error expected", err, conf)
+	}
+}
+
+func TestDBSCAN_ConfErrorMinPts(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1, MinPts: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestDBSCAN_ConfErrorMinClusterSize(t *testing.T) {
+	var conf = dbscan.Conf{HDBSCAN: true, MinClusterSize: -2}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
@@ -0,0 +1,83 @@
+package dbscan
+
+import (
+	"github.com/wearelumenai/distclus/core"
+)
+
+// NoiseLabel is the label of elements that do not belong to any cluster
+const NoiseLabel = -1
+
+// Neighborhoods keeps the neighbors of elements within a given radius.
+// Elements can be appended without computing again distances between known elements.
+type Neighborhoods struct {
+	eps       float64
+	neighbors [][]int
+}
+
+// NewNeighborhoods creates empty neighborhoods of the given radius
+func NewNeighborhoods(eps float64) *Neighborhoods {
+	return &Neighborhoods{eps: eps}
+}
+
+// Update computes neighbors of elements appended since the last update
+func (n *Neighborhoods) Update(data []core.Elemt, space core.Space) {
+	if len(data) < len(n.neighbors) {
+		n.Reset()
+	}
+	for i := len(n.neighbors); i < len(data); i++ {
+		var neighbors = []int{i}
+		for j := 0; j < i; j++ {
+			if space.Dist(data[i], data[j]) <= n.eps {
+				neighbors = append(neighbors, j)
+				n.neighbors[j] = append(n.neighbors[j], i)
+			}
+		}
+		n.neighbors = append(n.neighbors, neighbors)
+	}
+}
+
+// Reset forgets all neighborhoods
+func (n *Neighborhoods) Reset() {
+	n.neighbors = nil
+}
+
+// Labels returns DBSCAN labels: elements whose neighborhood weight is at least minPts are core elements,
+// clusters are made of core elements connected by neighborhoods and of their neighbors.
+// Other elements are labeled with NoiseLabel. Nil weights stand for unit weights.
+func (n *Neighborhoods) Labels(weights []int, minPts int) (labels []int, k int) {
+	var size = len(n.neighbors)
+	var isCore = make([]bool, size)
+	for i, neighbors := range n.neighbors {
+		var weight = 0
+		for _, j := range neighbors {
+			weight += core.Weight(weights, j)
+		}
+		isCore[i] = weight >= minPts
+	}
+
+	labels = make([]int, size)
+	for i := range labels {
+		labels[i] = NoiseLabel
+	}
+	for i := 0; i < size; i++ {
+		if !isCore[i] || labels[i] != NoiseLabel {
+			continue
+		}
+		labels[i] = k
+		var queue = []int{i}
+		for len(queue) > 0 {
+			var current = queue[0]
+			queue = queue[1:]
+			for _, j := range n.neighbors[current] {
+				if labels[j] == NoiseLabel {
+					labels[j] = k
+					if isCore[j] {
+						queue = append(queue, j)
+					}
+				}
+			}
+		}
+		k++
+	}
+	return
+}
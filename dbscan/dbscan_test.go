@@ -0,0 +1,98 @@
+package dbscan_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dbscan"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var blobs = []core.Elemt{
+	[]float64{0, 0}, []float64{0, 1}, []float64{1, 0}, []float64{1, 1}, []float64{.5, .5},
+	[]float64{10, 10}, []float64{10, 11}, []float64{11, 10}, []float64{11, 11}, []float64{10.5, 10.5},
+	[]float64{0, 20}, []float64{0, 21}, []float64{1, 20}, []float64{1, 21}, []float64{.5, 20.5},
+	[]float64{30, -10}, []float64{-15, 5},
+}
+
+var blobLabels = []int{0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, dbscan.NoiseLabel, dbscan.NoiseLabel}
+
+func TestNeighborhoods_Labels(t *testing.T) {
+	var neighborhoods = dbscan.NewNeighborhoods(1.5)
+	neighborhoods.Update(blobs, space)
+	var labels, k = neighborhoods.Labels(nil, 4)
+
+	test.AssertEqual(t, 3, k)
+	test.AssertArrayEqual(t, blobLabels, labels)
+}
+
+func TestNeighborhoods_Border(t *testing.T) {
+	var data = []core.Elemt{[]float64{0}, []float64{1}, []float64{2}, []float64{3.5}, []float64{10}}
+	var neighborhoods = dbscan.NewNeighborhoods(1.5)
+	neighborhoods.Update(data, space)
+	var labels, k = neighborhoods.Labels(nil, 3)
+
+	test.AssertEqual(t, 1, k)
+	test.AssertArrayEqual(t, []int{0, 0, 0, 0, dbscan.NoiseLabel}, labels)
+}
+
+func TestNeighborhoods_Weights(t *testing.T) {
+	var data = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}}
+	var neighborhoods = dbscan.NewNeighborhoods(1.5)
+	neighborhoods.Update(data, space)
+
+	var labels, k = neighborhoods.Labels(nil, 3)
+	test.AssertEqual(t, 0, k)
+	test.AssertArrayEqual(t, []int{dbscan.NoiseLabel, dbscan.NoiseLabel, dbscan.NoiseLabel}, labels)
+
+	labels, k = neighborhoods.Labels([]int{2, 1, 3}, 3)
+	test.AssertEqual(t, 2, k)
+	test.AssertArrayEqual(t, []int{0, 0, 1}, labels)
+}
+
+func TestNeighborhoods_Incremental(t *testing.T) {
+	var incremental = dbscan.NewNeighborhoods(1.5)
+	incremental.Update(blobs[:7], space)
+	incremental.Update(blobs, space)
+	var full = dbscan.NewNeighborhoods(1.5)
+	full.Update(blobs, space)
+
+	var actual, _ = incremental.Labels(nil, 4)
+	var expected, _ = full.Labels(nil, 4)
+	test.AssertArrayEqual(t, expected, actual)
+}
+
+func TestDistances_Labels(t *testing.T) {
+	var distances = dbscan.NewDistances()
+	distances.Update(blobs, space)
+	var labels, k = distances.Labels(nil, 4, 4)
+
+	test.AssertEqual(t, 3, k)
+	test.AssertArrayEqual(t, blobLabels, labels)
+}
+
+func TestDistances_Incremental(t *testing.T) {
+	var incremental = dbscan.NewDistances()
+	incremental.Update(blobs[:7], space)
+	incremental.Update(blobs, space)
+	var full = dbscan.NewDistances()
+	full.Update(blobs, space)
+
+	for i := range blobs {
+		for j := range blobs {
+			test.AssertEqual(t, full.Get(i, j), incremental.Get(i, j))
+		}
+	}
+}
+
+func TestDistances_Empty(t *testing.T) {
+	var distances = dbscan.NewDistances()
+	var labels, k = distances.Labels(nil, 4, 4)
+	test.AssertEqual(t, 0, k)
+	test.AssertEqual(t, 0, len(labels))
+
+	distances.Update(blobs[:1], space)
+	labels, k = distances.Labels(nil, 4, 4)
+	test.AssertEqual(t, 0, k)
+	test.AssertArrayEqual(t, []int{dbscan.NoiseLabel}, labels)
+}
@@ -0,0 +1,8 @@
+package dbscan
+
+const (
+	// Noise is the number of elements labeled as noise
+	Noise = "noise"
+	// Clusters is the number of clusters found
+	Clusters = "clusters"
+)
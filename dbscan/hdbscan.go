@@ -0,0 +1,279 @@
+package dbscan
+
+import (
+	"math"
+	"sort"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Distances keeps distances between elements.
+// Elements can be appended without computing again distances between known elements.
+type Distances struct {
+	dists [][]float64 // dists[i][j] is the distance between elements i and j < i
+}
+
+// NewDistances creates empty distances
+func NewDistances() *Distances {
+	return &Distances{}
+}
+
+// Update computes distances of elements appended since the last update
+func (d *Distances) Update(data []core.Elemt, space core.Space) {
+	if len(data) < len(d.dists) {
+		d.Reset()
+	}
+	for i := len(d.dists); i < len(data); i++ {
+		var row = make([]float64, i)
+		for j := range row {
+			row[j] = space.Dist(data[i], data[j])
+		}
+		d.dists = append(d.dists, row)
+	}
+}
+
+// Reset forgets all distances
+func (d *Distances) Reset() {
+	d.dists = nil
+}
+
+// Get returns the distance between elements i and j
+func (d *Distances) Get(i, j int) float64 {
+	switch {
+	case i > j:
+		return d.dists[i][j]
+	case i < j:
+		return d.dists[j][i]
+	default:
+		return 0
+	}
+}
+
+// maxLambda bounds the density level of elements at null distance
+const maxLambda = 1e100
+
+func lambda(dist float64) float64 {
+	if dist <= 1/maxLambda {
+		return maxLambda
+	}
+	return 1 / dist
+}
+
+// dendrogram node, leaves are elements
+type node struct {
+	left, right int
+	dist        float64
+	weight      int
+}
+
+// cluster of the condensed tree
+type condensed struct {
+	parent    int
+	birth     float64
+	stability float64
+	children  []int
+}
+
+// Labels returns HDBSCAN labels: clusters are the most stable ones of the hierarchy built from mutual reachability
+// distances (core distances are distances to the minPts-th nearest neighbor), ignoring clusters lighter than minClusterSize.
+// Other elements are labeled with NoiseLabel. Nil weights stand for unit weights.
+func (d *Distances) Labels(weights []int, minPts int, minClusterSize int) (labels []int, k int) {
+	var size = len(d.dists)
+	labels = make([]int, size)
+	for i := range labels {
+		labels[i] = NoiseLabel
+	}
+	if size == 0 {
+		return
+	}
+
+	var nodes = d.dendrogram(weights, d.coreDists(weights, minPts))
+	var clusters, pointClusters = condense(nodes, size, weights, minClusterSize)
+	var selected = selectClusters(clusters)
+
+	// clusters are labeled in the order of their first element
+	var clusterLabels = make([]int, len(clusters))
+	for c := range clusterLabels {
+		clusterLabels[c] = NoiseLabel
+	}
+	for p, c := range pointClusters {
+		for ; c > 0 && !selected[c]; c = clusters[c].parent {
+		}
+		if c > 0 {
+			if clusterLabels[c] == NoiseLabel {
+				clusterLabels[c] = k
+				k++
+			}
+			labels[p] = clusterLabels[c]
+		}
+	}
+	return
+}
+
+// coreDists returns for each element the smallest radius of a neighborhood of weight at least minPts
+func (d *Distances) coreDists(weights []int, minPts int) []float64 {
+	var size = len(d.dists)
+	var coreDists = make([]float64, size)
+	var order = make([]int, size)
+	for i := range coreDists {
+		for j := range order {
+			order[j] = j
+		}
+		sort.Slice(order, func(a, b int) bool { return d.Get(i, order[a]) < d.Get(i, order[b]) })
+		var weight = 0
+		for _, j := range order {
+			weight += core.Weight(weights, j)
+			coreDists[i] = d.Get(i, j)
+			if weight >= minPts {
+				break
+			}
+		}
+	}
+	return coreDists
+}
+
+// dendrogram returns the single linkage hierarchy of mutual reachability distances,
+// the first nodes are elements and the last one is the root
+func (d *Distances) dendrogram(weights []int, coreDists []float64) []node {
+	var size = len(d.dists)
+	var mrd = func(i, j int) float64 {
+		return math.Max(d.Get(i, j), math.Max(coreDists[i], coreDists[j]))
+	}
+
+	// minimum spanning tree with Prim's algorithm
+	type edge struct {
+		a, b int
+		dist float64
+	}
+	var edges = make([]edge, 0, size-1)
+	var inTree = make([]bool, size)
+	var best = make([]float64, size)
+	var from = make([]int, size)
+	for j := range best {
+		best[j] = math.Inf(1)
+	}
+	var current = 0
+	for len(edges) < size-1 {
+		inTree[current] = true
+		var next = -1
+		for j := 0; j < size; j++ {
+			if inTree[j] {
+				continue
+			}
+			if dist := mrd(current, j); dist < best[j] {
+				best[j], from[j] = dist, current
+			}
+			if next < 0 || best[j] < best[next] {
+				next = j
+			}
+		}
+		edges = append(edges, edge{from[next], next, best[next]})
+		current = next
+	}
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].dist < edges[j].dist })
+
+	// merge components in increasing distance order
+	var nodes = make([]node, size, 2*size-1)
+	var parents = make([]int, 2*size-1)
+	for i := range nodes {
+		nodes[i] = node{left: -1, right: -1, weight: core.Weight(weights, i)}
+	}
+	for i := range parents {
+		parents[i] = i
+	}
+	var find = func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+	for _, e := range edges {
+		var a, b = find(e.a), find(e.b)
+		var id = len(nodes)
+		nodes = append(nodes, node{left: a, right: b, dist: e.dist, weight: nodes[a].weight + nodes[b].weight})
+		parents[a], parents[b] = id, id
+	}
+	return nodes
+}
+
+// condense returns the clusters of the hierarchy heavier than minClusterSize, the first one being the root,
+// and for each element the cluster it falls out of
+func condense(nodes []node, size int, weights []int, minClusterSize int) (clusters []condensed, pointClusters []int) {
+	clusters = []condensed{{parent: -1}}
+	pointClusters = make([]int, size)
+
+	var fallOut = func(n int, c int, l float64) {
+		var stack = []int{n}
+		for len(stack) > 0 {
+			var current = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if current < size {
+				pointClusters[current] = c
+				clusters[c].stability += (l - clusters[c].birth) * float64(core.Weight(weights, current))
+			} else {
+				stack = append(stack, nodes[current].left, nodes[current].right)
+			}
+		}
+	}
+
+	type item struct{ node, cluster int }
+	var stack = []item{{len(nodes) - 1, 0}}
+	for len(stack) > 0 {
+		var current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		var n = nodes[current.node]
+		if current.node < size {
+			pointClusters[current.node] = current.cluster
+			continue
+		}
+		var l = lambda(n.dist)
+		var left, right = nodes[n.left], nodes[n.right]
+		switch {
+		case left.weight >= minClusterSize && right.weight >= minClusterSize:
+			clusters[current.cluster].stability += (l - clusters[current.cluster].birth) * float64(n.weight)
+			for _, child := range []int{n.left, n.right} {
+				var id = len(clusters)
+				clusters = append(clusters, condensed{parent: current.cluster, birth: l})
+				clusters[current.cluster].children = append(clusters[current.cluster].children, id)
+				stack = append(stack, item{child, id})
+			}
+		case left.weight >= minClusterSize:
+			fallOut(n.right, current.cluster, l)
+			stack = append(stack, item{n.left, current.cluster})
+		case right.weight >= minClusterSize:
+			fallOut(n.left, current.cluster, l)
+			stack = append(stack, item{n.right, current.cluster})
+		default:
+			fallOut(current.node, current.cluster, l)
+		}
+	}
+	return
+}
+
+// selectClusters returns the clusters that maximize the total stability (excess of mass), the root being excluded
+func selectClusters(clusters []condensed) (selected []bool) {
+	selected = make([]bool, len(clusters))
+	var best = make([]float64, len(clusters))
+	var deselect func(c int)
+	deselect = func(c int) {
+		for _, child := range clusters[c].children {
+			selected[child] = false
+			deselect(child)
+		}
+	}
+	for c := len(clusters) - 1; c > 0; c-- {
+		var children = 0.
+		for _, child := range clusters[c].children {
+			children += best[child]
+		}
+		if len(clusters[c].children) == 0 || clusters[c].stability >= children {
+			selected[c] = true
+			best[c] = clusters[c].stability
+			deselect(c)
+		} else {
+			best[c] = children
+		}
+	}
+	return
+}
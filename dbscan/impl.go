@@ -0,0 +1,208 @@
+package dbscan
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Impl algorithm implementation.
+// Neighborhoods (or distances for HDBSCAN) are kept between iterations so that only pushed elements
+// need new distance computations, unless the buffer has a fixed size and elements are replaced.
+type Impl struct {
+	buffer        core.Buffer
+	frameSize     int
+	neighborhoods *Neighborhoods
+	distances     *Distances
+	pushed        int64 // number of buffered elements, accessed atomically
+	applied       int64 // number of buffered elements when labels were last computed
+	computed      bool  // labels are up to date with buffered elements
+	mu            sync.RWMutex
+	clust         core.Clust
+	labels        []int
+	stats         []core.ClusterStats
+}
+
+// NewImpl creates a new dbscan implementation
+func NewImpl(conf Conf, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer:        core.NewDataBuffer(data, conf.FrameSize),
+		frameSize:     conf.FrameSize,
+		neighborhoods: NewNeighborhoods(conf.Eps),
+		distances:     NewDistances(),
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	impl.applied = atomic.LoadInt64(&impl.pushed)
+	impl.computed = false
+	impl.resetCache()
+	_ = impl.buffer.Apply()
+	if len(impl.buffer.Data()) == 0 {
+		err = errors.New("at least one element is needed")
+		return
+	}
+	clust, _ = impl.compute(model)
+	return
+}
+
+// Iterate labels buffered elements again if elements have been pushed since the last iteration
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var pushed = atomic.LoadInt64(&impl.pushed)
+	err = impl.buffer.Apply()
+	if pushed != impl.applied {
+		impl.resetCache()
+		impl.applied = pushed
+		impl.computed = false
+	}
+	clust, runtimeFigures = impl.compute(model)
+	return
+}
+
+// resetCache forgets neighborhoods and distances if buffered elements may have been replaced
+func (impl *Impl) resetCache() {
+	if impl.frameSize > 0 {
+		impl.neighborhoods.Reset()
+		impl.distances.Reset()
+	}
+}
+
+// compute labels buffered elements and returns cluster representatives
+func (impl *Impl) compute(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+
+	impl.mu.Lock()
+	defer impl.mu.Unlock()
+
+	if !impl.computed {
+		var labels, k = impl.label(conf, data, weights, model.Space())
+		impl.clust, impl.stats = representatives(k, data, weights, labels, model.Space())
+		impl.labels = labels
+		impl.computed = true
+	}
+
+	var noise = 0.
+	for _, label := range impl.labels {
+		if label == NoiseLabel {
+			noise++
+		}
+	}
+	runtimeFigures = core.RuntimeFigures{
+		Noise:    noise,
+		Clusters: float64(len(impl.clust)),
+	}
+	return impl.clust, runtimeFigures
+}
+
+// label updates neighborhoods (or distances) and labels elements
+func (impl *Impl) label(conf *Conf, data []core.Elemt, weights []int, space core.Space) (labels []int, k int) {
+	if conf.HDBSCAN {
+		impl.distances.Update(data, space)
+		return impl.distances.Labels(weights, conf.MinPts, conf.MinClusterSize)
+	}
+	impl.neighborhoods.Update(data, space)
+	return impl.neighborhoods.Labels(weights, conf.MinPts)
+}
+
+// representatives returns the weighted average of each cluster and cluster statistics
+func representatives(k int, data []core.Elemt, weights []int, labels []int, space core.Space) (clust core.Clust, stats []core.ClusterStats) {
+	var members = make([][]core.Elemt, k)
+	var memberWeights = make([][]int, k)
+	for i, label := range labels {
+		if label != NoiseLabel {
+			members[label] = append(members[label], data[i])
+			memberWeights[label] = append(memberWeights[label], core.Weight(weights, i))
+		}
+	}
+	clust = make(core.Clust, k)
+	for label := range clust {
+		clust[label], _ = core.WeightedDBA(members[label], memberWeights[label], space)
+	}
+	stats = make([]core.ClusterStats, k)
+	for i, label := range labels {
+		if label != NoiseLabel {
+			core.AddToStats(&stats[label], core.Weight(weights, i), space.Dist(data[i], clust[label]), norm)
+		}
+	}
+	return
+}
+
+// Labels returns the labels of buffered elements computed by the last iteration, noise being labeled with NoiseLabel
+func (impl *Impl) Labels() []int {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	var labels = make([]int, len(impl.labels))
+	copy(labels, impl.labels)
+	return labels
+}
+
+// Noise returns buffered elements labeled as noise by the last iteration
+func (impl *Impl) Noise() (noise []core.Elemt) {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	var data = impl.buffer.Data()
+	for i, label := range impl.labels {
+		if label == NoiseLabel && i < len(data) {
+			noise = append(noise, data[i])
+		}
+	}
+	return
+}
+
+// Stats returns cluster statistics of the last iteration
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) (err error) {
+	if err = impl.buffer.Push(elemt, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) (err error) {
+	if err = impl.buffer.PushWeighted(elemt, weight, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	impl.mu.Lock()
+	defer impl.mu.Unlock()
+	impl.neighborhoods.Reset()
+	impl.distances.Reset()
+	impl.computed = false
+	return impl.buffer.Load(r)
+}
@@ -0,0 +1,127 @@
+package dbscan_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dbscan"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func TestImpl_Noise(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1.5, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, blobs)
+	test.AssertNoError(t, algo.Batch())
+
+	var impl = algo.Impl().(*dbscan.Impl)
+	test.AssertArrayEqual(t, blobLabels, impl.Labels())
+	test.AssertEqual(t, blobs[15:], impl.Noise())
+	test.AssertCentroids(t, core.Clust{[]float64{.5, .5}, []float64{10.5, 10.5}, []float64{.5, 20.5}}, algo.Centroids())
+
+	var figures = algo.RuntimeFigures()
+	test.AssertEqual(t, 2., figures[dbscan.Noise])
+	test.AssertEqual(t, 3., figures[dbscan.Clusters])
+}
+
+func TestImpl_HDBSCAN(t *testing.T) {
+	var conf = dbscan.Conf{HDBSCAN: true, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, blobs)
+	test.AssertNoError(t, algo.Batch())
+
+	var impl = algo.Impl().(*dbscan.Impl)
+	test.AssertArrayEqual(t, blobLabels, impl.Labels())
+	test.AssertCentroids(t, core.Clust{[]float64{.5, .5}, []float64{10.5, 10.5}, []float64{.5, 20.5}}, algo.Centroids())
+}
+
+func TestImpl_Push(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1.5, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, blobs[:10])
+	test.AssertNoError(t, algo.Batch())
+	test.AssertEqual(t, 2, len(algo.Centroids()))
+
+	for _, elemt := range blobs[10:] {
+		test.AssertNoError(t, algo.Push(elemt))
+	}
+	test.AssertNoError(t, algo.Batch())
+
+	var impl = algo.Impl().(*dbscan.Impl)
+	test.AssertArrayEqual(t, blobLabels, impl.Labels())
+	test.AssertEqual(t, 3, len(algo.Centroids()))
+}
+
+func TestImpl_Frame(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1.5, FrameSize: 5, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, blobs[:5])
+	test.AssertNoError(t, algo.Batch())
+	test.AssertCentroids(t, core.Clust{[]float64{.5, .5}}, algo.Centroids())
+
+	for _, elemt := range blobs[5:10] {
+		test.AssertNoError(t, algo.Push(elemt))
+	}
+	test.AssertNoError(t, algo.Batch())
+	test.AssertCentroids(t, core.Clust{[]float64{10.5, 10.5}}, algo.Centroids())
+}
+
+func TestImpl_FrameReplaced(t *testing.T) {
+	var conf = dbscan.Conf{Eps: .5, MinPts: 2, FrameSize: 6, CtrlConf: core.CtrlConf{Iter: 1}}
+	var data = []core.Elemt{[]float64{0}, []float64{.1}, []float64{.2}, []float64{10}, []float64{10.1}, []float64{10.2}}
+	var algo = dbscan.NewAlgo(conf, space, data)
+	test.AssertNoError(t, algo.Batch())
+	test.AssertCentroids(t, core.Clust{[]float64{.1}, []float64{10.1}}, algo.Centroids())
+
+	for _, x := range []float64{100, 100.1, 100.2, 100.3, 100.4, 100.5} {
+		test.AssertNoError(t, algo.Push([]float64{x}))
+	}
+	test.AssertNoError(t, algo.Batch())
+	test.AssertCentroids(t, core.Clust{[]float64{100.25}}, algo.Centroids())
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1.5, CtrlConf: core.CtrlConf{Iter: 1}}
+	var weighted = dbscan.NewAlgo(conf, space, nil)
+	var duplicated = dbscan.NewAlgo(conf, space, nil)
+	for i, elemt := range blobs {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, duplicated.Centroids(), weighted.Centroids())
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1.5, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, blobs)
+	test.AssertNoError(t, algo.Batch())
+
+	var stats = algo.Stats()
+	test.AssertEqual(t, 3, len(stats))
+	for i := range stats {
+		test.AssertEqual(t, 5, stats[i].Size)
+		test.AssertAlmostEqual(t, 2., stats[i].Loss)
+	}
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = dbscan.Conf{Eps: 1.5, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = dbscan.NewAlgo(conf, space, blobs)
+	test.AssertNoError(t, algo.Batch())
+
+	var saved bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&saved))
+	var impl = dbscan.NewImpl(conf, nil)
+	var restored, err = core.Restore(&saved, &conf, &impl, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, restored.Batch())
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+}
@@ -0,0 +1,82 @@
+package dtw
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+// BinaryCodec encodes series as their length followed by binary encoded points
+type BinaryCodec struct{}
+
+// Encode returns the binary encoding of a series
+func (BinaryCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	var series, ok = elemt.([][]float64)
+	if !ok {
+		return nil, core.ErrEncode
+	}
+	var header [binary.MaxVarintLen64]byte
+	var n = binary.PutUvarint(header[:], uint64(len(series)))
+	var buf = append([]byte{}, header[:n]...)
+	for _, point := range series {
+		buf = euclid.AppendPoint(buf, point)
+	}
+	return buf, nil
+}
+
+// Decode returns the series encoded in data
+func (BinaryCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var size, n = binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return nil, core.ErrDecode
+	}
+	data = data[n:]
+	var series = make([][]float64, size)
+	for i := 0; i < len(series) && err == nil; i++ {
+		series[i], data, err = euclid.ReadPoint(data)
+	}
+	if err == nil && len(data) > 0 {
+		err = core.ErrDecode
+	}
+	if err == nil {
+		elemt = series
+	}
+	return
+}
+
+// JSONCodec encodes series as JSON arrays of arrays of numbers
+type JSONCodec struct{}
+
+// Encode returns the JSON encoding of a series
+func (JSONCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	var series, ok = elemt.([][]float64)
+	if !ok {
+		return nil, core.ErrEncode
+	}
+	return json.Marshal(series)
+}
+
+// Decode returns the series encoded in data
+func (JSONCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var series [][]float64
+	err = json.Unmarshal(data, &series)
+	if err == nil {
+		elemt = series
+	}
+	return
+}
+
+// Codec returns the series codec for the given format
+func (space Space) Codec(format core.Format) (codec core.Codec, err error) {
+	switch format {
+	case core.Binary:
+		codec = BinaryCodec{}
+	case core.JSON:
+		codec = JSONCodec{}
+	default:
+		err = core.ErrFormat
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package dtw_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dtw"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+func TestCodec(t *testing.T) {
+	var space = dtw.NewSpace(dtw.Conf{InnerSpace: euclid.NewSpace()})
+	var elemt = [][]float64{{1.5, -2}, {0, 1e10}, {3, 4}}
+	for _, format := range []core.Format{core.Binary, core.JSON} {
+		var codec, err = space.Codec(format)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		data, err := codec.Encode(elemt)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		decoded, err := codec.Decode(data)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		if !reflect.DeepEqual(elemt, decoded) {
+			t.Error("Expected", elemt, "got", decoded)
+		}
+	}
+}
+
+func TestBinaryCodec_Malformed(t *testing.T) {
+	var codec = dtw.BinaryCodec{}
+	var data, _ = codec.Encode([][]float64{{1, 2}, {3, 4}})
+	if _, err := codec.Decode(data[:len(data)-1]); err != core.ErrDecode {
+		t.Error("decode error expected", err)
+	}
+	if _, err := codec.Decode(append(data, 0)); err != core.ErrDecode {
+		t.Error("decode error expected", err)
+	}
+}
+
+func TestCodec_WrongType(t *testing.T) {
+	for _, codec := range []core.Codec{dtw.BinaryCodec{}, dtw.JSONCodec{}} {
+		if _, err := codec.Encode([]float64{1, 2}); err != core.ErrEncode {
+			t.Error("encode error expected", err)
+		}
+	}
+}
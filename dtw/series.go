@@ -1,9 +1,15 @@
 package dtw
 
 import (
+	"encoding/gob"
+
 	"github.com/wearelumenai/distclus/core"
 )
 
+func init() {
+	gob.Register([][]float64{})
+}
+
 // Space for processing vectors of vectors ([][]float64)
 type Space struct {
 	window     int
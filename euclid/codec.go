@@ -0,0 +1,96 @@
+package euclid
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// BinaryCodec encodes vectors as their length followed by little endian float64 values
+type BinaryCodec struct{}
+
+// Encode returns the binary encoding of a vector
+func (BinaryCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	var point, ok = elemt.([]float64)
+	if !ok {
+		return nil, core.ErrEncode
+	}
+	return AppendPoint(nil, point), nil
+}
+
+// Decode returns the vector encoded in data
+func (BinaryCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var point []float64
+	point, data, err = ReadPoint(data)
+	if err == nil && len(data) > 0 {
+		err = core.ErrDecode
+	}
+	if err == nil {
+		elemt = point
+	}
+	return
+}
+
+// AppendPoint appends the binary encoding of a point to buf
+func AppendPoint(buf []byte, point []float64) []byte {
+	var header [binary.MaxVarintLen64]byte
+	var n = binary.PutUvarint(header[:], uint64(len(point)))
+	buf = append(buf, header[:n]...)
+	var value [8]byte
+	for _, x := range point {
+		binary.LittleEndian.PutUint64(value[:], math.Float64bits(x))
+		buf = append(buf, value[:]...)
+	}
+	return buf
+}
+
+// ReadPoint reads a binary encoded point and returns remaining data
+func ReadPoint(data []byte) (point []float64, rest []byte, err error) {
+	var size, n = binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n)/8 {
+		return nil, data, core.ErrDecode
+	}
+	data = data[n:]
+	point = make([]float64, size)
+	for i := range point {
+		point[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+	return point, data[size*8:], nil
+}
+
+// JSONCodec encodes vectors as JSON arrays of numbers
+type JSONCodec struct{}
+
+// Encode returns the JSON encoding of a vector
+func (JSONCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	var point, ok = elemt.([]float64)
+	if !ok {
+		return nil, core.ErrEncode
+	}
+	return json.Marshal(point)
+}
+
+// Decode returns the vector encoded in data
+func (JSONCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var point []float64
+	err = json.Unmarshal(data, &point)
+	if err == nil {
+		elemt = point
+	}
+	return
+}
+
+// Codec returns the vector codec for the given format
+func (space Space) Codec(format core.Format) (codec core.Codec, err error) {
+	switch format {
+	case core.Binary:
+		codec = BinaryCodec{}
+	case core.JSON:
+		codec = JSONCodec{}
+	default:
+		err = core.ErrFormat
+	}
+	return
+}
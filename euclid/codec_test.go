@@ -0,0 +1,59 @@
+package euclid_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+func TestCodec(t *testing.T) {
+	var space = euclid.NewSpace()
+	var elemt = []float64{1.5, -2, 0, 1e10}
+	for _, format := range []core.Format{core.Binary, core.JSON} {
+		var codec, err = space.Codec(format)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		data, err := codec.Encode(elemt)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		decoded, err := codec.Decode(data)
+		if err != nil {
+			t.Error("no error expected", err)
+		}
+		if !reflect.DeepEqual(elemt, decoded) {
+			t.Error("Expected", elemt, "got", decoded)
+		}
+	}
+}
+
+func TestCodec_Format(t *testing.T) {
+	if _, err := euclid.NewSpace().Codec(core.Format(-1)); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+}
+
+func TestBinaryCodec_Malformed(t *testing.T) {
+	var codec = euclid.BinaryCodec{}
+	var data, _ = codec.Encode([]float64{1, 2})
+	if _, err := codec.Decode(data[:len(data)-1]); err != core.ErrDecode {
+		t.Error("decode error expected", err)
+	}
+	if _, err := codec.Decode(append(data, 0)); err != core.ErrDecode {
+		t.Error("decode error expected", err)
+	}
+	if _, err := codec.Decode(nil); err != core.ErrDecode {
+		t.Error("decode error expected", err)
+	}
+}
+
+func TestCodec_WrongType(t *testing.T) {
+	for _, codec := range []core.Codec{euclid.BinaryCodec{}, euclid.JSONCodec{}} {
+		if _, err := codec.Encode("1,2"); err != core.ErrEncode {
+			t.Error("encode error expected", err)
+		}
+	}
+}
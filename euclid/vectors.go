@@ -2,11 +2,16 @@
 package euclid
 
 import (
+	"encoding/gob"
 	"math"
 
 	"github.com/wearelumenai/distclus/core"
 )
 
+func init() {
+	gob.Register([]float64{})
+}
+
 // Space for vectors ([]float64)
 type Space struct{}
 
@@ -22,6 +27,11 @@ func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
 	return space.PointDist(e1, e2)
 }
 
+// IsMetric returns true since the Euclidean distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
 // PointDist returns distance points
 func (space Space) PointDist(point1 []float64, point2 []float64) float64 {
 	var sum = 0.
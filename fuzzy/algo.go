@@ -0,0 +1,12 @@
+// Package fuzzy provides fuzzy c-means implementation of online clustering.
+// Elements belong to all clusters with membership degrees that sum to 1.
+package fuzzy
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new fuzzy c-means algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, initializer core.Initializer, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, initializer, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
@@ -0,0 +1,53 @@
+package fuzzy_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/fuzzy"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+func rgen() *rand.Rand {
+	return rand.New(rand.NewSource(6305689164243))
+}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = fuzzy.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}, RGen: rgen()}
+	var algo = fuzzy.NewAlgo(conf, space, nil, kmeans.GivenInitializer)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_Initialization(t *testing.T) {
+	var algo = fuzzy.NewAlgo(fuzzy.Conf{K: 3}, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestInitialization(t, algo)
+}
+
+func Test_RunSyncGiven(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	var algo = fuzzy.NewAlgo(conf, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestRunSyncGiven(t, algo)
+}
+
+func Test_RunSyncPP(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 20}, RGen: rgen()}
+	var algo = fuzzy.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000}, RGen: rgen()}
+	var algo = fuzzy.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestWorkflow(t, algo)
+}
@@ -0,0 +1,47 @@
+package fuzzy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of fuzzy c-means
+type Conf struct {
+	core.CtrlConf
+	K         int
+	M         float64 // fuzzifier, greater than 1, 2 if 0. The larger the fuzzier memberships
+	Precision int     // resolution of memberships converted to integer weights for space Combine, 1000 if 0
+	FrameSize int
+	RGen      *rand.Rand
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	if conf.K < 1 {
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	} else if conf.M <= 1 {
+		err = fmt.Errorf("Illegal value for M: %v", conf.M)
+	} else if conf.Precision < 1 {
+		err = fmt.Errorf("Illegal value for Precision: %v", conf.Precision)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.M == 0 {
+		conf.M = 2
+	}
+	if conf.Precision == 0 {
+		conf.Precision = 1000
+	}
+}
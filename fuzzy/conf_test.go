@@ -0,0 +1,39 @@
+package fuzzy_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/fuzzy"
+)
+
+func TestFuzzy_ConfErrorK(t *testing.T) {
+	var conf = fuzzy.Conf{K: -12}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestFuzzy_ConfErrorM(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, M: 1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestFuzzy_ConfErrorPrecision(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, Precision: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestFuzzy_ConfDefault(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3}
+	var err = conf.Verify()
+	if err != nil || conf.M != 2 || conf.Precision != 1000 || conf.RGen == nil {
+		t.Error("default values expected", err, conf)
+	}
+}
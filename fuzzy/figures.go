@@ -0,0 +1,6 @@
+package fuzzy
+
+const (
+	// Objective is the fuzzy c-means objective: the sum of squared distances to centroids weighted by memberships
+	Objective = "objective"
+)
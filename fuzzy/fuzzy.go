@@ -0,0 +1,73 @@
+package fuzzy
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Membership returns the membership degrees of an element to each centroid given the fuzzifier m.
+// An element at null distance of centroids belongs equally to them only.
+func Membership(elemt core.Elemt, centroids core.Clust, space core.Space, m float64) (membership []float64) {
+	membership = make([]float64, len(centroids))
+	var dists = make([]float64, len(centroids))
+	var zeros = 0
+	for k := range centroids {
+		dists[k] = space.Dist(elemt, centroids[k])
+		if dists[k] == 0 {
+			zeros++
+		}
+	}
+	if zeros > 0 {
+		for k := range dists {
+			if dists[k] == 0 {
+				membership[k] = 1 / float64(zeros)
+			}
+		}
+		return
+	}
+
+	var exponent = 2 / (m - 1)
+	for k := range dists {
+		var sum = 0.
+		for j := range dists {
+			sum += math.Pow(dists[k]/dists[j], exponent)
+		}
+		membership[k] = 1 / sum
+	}
+	return
+}
+
+// Memberships returns the membership matrix of elements (rows) to centroids (columns) given the fuzzifier m
+func Memberships(elemts []core.Elemt, centroids core.Clust, space core.Space, m float64) (memberships [][]float64) {
+	memberships = make([][]float64, len(elemts))
+	for i := range elemts {
+		memberships[i] = Membership(elemts[i], centroids, space, m)
+	}
+	return
+}
+
+// Centroids returns the averages of weighted elements where each element weight is multiplied by its membership
+// to the power of m. Combined weights are integers scaled by precision, the previous centroid is kept
+// if all its weights are null. Nil weights stand for unit weights.
+func Centroids(centroids core.Clust, elemts []core.Elemt, weights []int, memberships [][]float64, space core.Space, m float64, precision int) (result core.Clust, objective float64) {
+	result = make(core.Clust, len(centroids))
+	for k := range centroids {
+		var members []core.Elemt
+		var memberWeights []int
+		for i := range elemts {
+			var weight = float64(core.Weight(weights, i)) * math.Pow(memberships[i][k], m)
+			objective += weight * math.Pow(space.Dist(elemts[i], centroids[k]), 2)
+			if scaled := int(math.Round(weight * float64(precision))); scaled > 0 {
+				members = append(members, elemts[i])
+				memberWeights = append(memberWeights, scaled)
+			}
+		}
+		if len(members) == 0 {
+			result[k] = centroids[k]
+		} else {
+			result[k], _ = core.WeightedDBA(members, memberWeights, space)
+		}
+	}
+	return
+}
@@ -0,0 +1,58 @@
+package fuzzy_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/fuzzy"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var centroids = core.Clust{[]float64{0, 0}, []float64{4, 0}}
+
+func TestMembership(t *testing.T) {
+	// distances 1 and 3 with m = 2: memberships are proportional to 1/1 and 1/9
+	var membership = fuzzy.Membership([]float64{1, 0}, centroids, space, 2)
+	test.AssertArrayAlmostEqual(t, []float64{.9, .1}, membership)
+
+	// m = 3: memberships are proportional to 1/1 and 1/3
+	membership = fuzzy.Membership([]float64{1, 0}, centroids, space, 3)
+	test.AssertArrayAlmostEqual(t, []float64{.75, .25}, membership)
+
+	membership = fuzzy.Membership([]float64{2, 5}, centroids, space, 2)
+	test.AssertArrayAlmostEqual(t, []float64{.5, .5}, membership)
+}
+
+func TestMembership_Zero(t *testing.T) {
+	var clust = core.Clust{[]float64{0, 0}, []float64{4, 0}, []float64{0, 0}}
+	var membership = fuzzy.Membership([]float64{0, 0}, clust, space, 2)
+	test.AssertArrayAlmostEqual(t, []float64{.5, 0, .5}, membership)
+}
+
+func TestMemberships(t *testing.T) {
+	var memberships = fuzzy.Memberships(test.Vectors, core.Clust(test.Vectors[:3]), space, 2)
+	test.AssertEqual(t, len(test.Vectors), len(memberships))
+	for i := range memberships {
+		var sum = 0.
+		for _, u := range memberships[i] {
+			sum += u
+		}
+		test.AssertAlmostEqual(t, 1, sum)
+	}
+}
+
+func TestCentroids(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{0, 0}, []float64{1, 0}, []float64{4, 0}}
+	var memberships = [][]float64{{1, 0}, {.5, .5}, {0, 1}}
+	var result, objective = fuzzy.Centroids(centroids, elemts, []int{1, 2, 1}, memberships, space, 2, 1000)
+
+	// weights are 1 and 2*.25 for the first centroid, 2*.25 and 1 for the second
+	test.AssertCentroids(t, core.Clust{[]float64{1. / 3, 0}, []float64{3, 0}}, result)
+	test.AssertAlmostEqual(t, .5*1+.5*9, objective)
+}
+
+func TestCentroids_Empty(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{1, 0}}
+	var result, _ = fuzzy.Centroids(centroids, elemts, nil, [][]float64{{1, 0}}, space, 2, 1000)
+	test.AssertCentroids(t, core.Clust{[]float64{1, 0}, []float64{4, 0}}, result)
+}
@@ -0,0 +1,123 @@
+package fuzzy
+
+import (
+	"io"
+	"sync"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Impl algorithm implementation
+type Impl struct {
+	buffer      core.Buffer
+	initializer core.Initializer
+	mu          sync.RWMutex
+	m           float64
+	space       core.Space
+	centroids   core.Clust
+	memberships [][]float64
+	stats       []core.ClusterStats
+}
+
+// NewImpl creates a new fuzzy c-means implementation
+func NewImpl(conf Conf, initializer core.Initializer, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer:      core.NewDataBuffer(data, conf.FrameSize),
+		initializer: initializer,
+		m:           conf.M,
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	var conf = model.Conf().(*Conf)
+	_ = impl.buffer.Apply()
+	clust, err = impl.initializer(conf.K, impl.buffer.Data(), model.Space(), conf.RGen)
+	if err == nil {
+		impl.mu.Lock()
+		impl.space, impl.centroids = model.Space(), clust
+		impl.mu.Unlock()
+	}
+	return
+}
+
+// Iterate computes memberships of buffered elements then moves centroids to membership weighted averages
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+	var space = model.Space()
+
+	var memberships = Memberships(data, model.Centroids(), space, conf.M)
+	var objective float64
+	clust, objective = Centroids(model.Centroids(), data, weights, memberships, space, conf.M, conf.Precision)
+	var stats = clust.ReduceStats(data, weights, space, norm)
+
+	impl.mu.Lock()
+	impl.m, impl.space, impl.centroids = conf.M, space, clust
+	impl.memberships, impl.stats = memberships, stats
+	impl.mu.Unlock()
+
+	runtimeFigures = core.RuntimeFigures{Objective: objective}
+	return clust, runtimeFigures, impl.buffer.Apply()
+}
+
+// Memberships returns the membership matrix of buffered elements (rows) to the centroids (columns)
+// computed by the last iteration
+func (impl *Impl) Memberships() [][]float64 {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.memberships
+}
+
+// Membership returns the membership degrees of an element to the current centroids,
+// nil if the algorithm is not initialized
+func (impl *Impl) Membership(elemt core.Elemt) []float64 {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	if len(impl.centroids) == 0 {
+		return nil
+	}
+	return Membership(elemt, impl.centroids, impl.space, impl.m)
+}
+
+// Stats returns cluster statistics of the last iteration, elements being assigned to the nearest centroid
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
+	return impl.buffer.Push(elemt, model.Status().Alive())
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) error {
+	return impl.buffer.PushWeighted(elemt, weight, model.Status().Alive())
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, impl.initializer, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	return impl.buffer.Load(r)
+}
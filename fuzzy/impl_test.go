@@ -0,0 +1,116 @@
+package fuzzy_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/fuzzy"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/manhattan"
+)
+
+var overlapping = []core.Elemt{
+	[]float64{0, 0}, []float64{0, 1}, []float64{1, 0}, []float64{1, 1},
+	[]float64{5, 0}, []float64{5, 1}, []float64{6, 0}, []float64{6, 1},
+	[]float64{3, .5},
+}
+
+func TestImpl_Memberships(t *testing.T) {
+	var conf = fuzzy.Conf{K: 2, CtrlConf: core.CtrlConf{Iter: 20}}
+	var init = core.Clust{[]float64{0, 0}, []float64{6, 1}}
+	var algo = fuzzy.NewAlgo(conf, space, overlapping, init.Initializer)
+	test.AssertNoError(t, algo.Batch())
+
+	// the configuration is symmetric around the last element which attracts both centroids
+	var centroids = roundClust(algo.Centroids())
+	test.AssertAlmostEqual(t, 6, centroids[0].([]float64)[0]+centroids[1].([]float64)[0])
+	test.AssertTrue(t, centroids[0].([]float64)[0] > .5)
+
+	var impl = algo.Impl().(*fuzzy.Impl)
+	var memberships = impl.Memberships()
+	test.AssertEqual(t, len(overlapping), len(memberships))
+	test.AssertTrue(t, memberships[0][0] > .9)
+	test.AssertTrue(t, memberships[4][1] > .9)
+	test.AssertArrayAlmostEqual(t, []float64{.5, .5}, roundArray(memberships[8]))
+	test.AssertArrayAlmostEqual(t, []float64{.5, .5}, roundArray(impl.Membership([]float64{3, 2})))
+
+	var figures = algo.RuntimeFigures()
+	var objective, ok = figures[fuzzy.Objective]
+	test.AssertTrue(t, ok && objective > 0)
+}
+
+func TestImpl_Manhattan(t *testing.T) {
+	var conf = fuzzy.Conf{K: 2, M: 1.5, CtrlConf: core.CtrlConf{Iter: 20}}
+	var init = core.Clust{[]float64{0, 0}, []float64{6, 1}}
+	var mspace = manhattan.NewSpace()
+	var algo = fuzzy.NewAlgo(conf, mspace, overlapping, init.Initializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var centroids = algo.Centroids()
+	var labels, _ = centroids.MapLabel(overlapping[:8], mspace)
+	test.AssertArrayEqual(t, []int{0, 0, 0, 0, 1, 1, 1, 1}, labels)
+}
+
+func TestImpl_NotInitialized(t *testing.T) {
+	var impl = fuzzy.NewImpl(fuzzy.Conf{K: 2}, kmeans.GivenInitializer, nil)
+	if impl.Membership([]float64{0, 0}) != nil {
+		t.Error("nil expected")
+	}
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	var centroids = core.Clust(test.Vectors[:3])
+	var weighted = fuzzy.NewAlgo(conf, space, nil, centroids.Initializer)
+	var duplicated = fuzzy.NewAlgo(conf, space, nil, centroids.Initializer)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, roundClust(duplicated.Centroids()), roundClust(weighted.Centroids()))
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = fuzzy.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	var centroids = core.Clust(test.Vectors[:3])
+	var algo = fuzzy.NewAlgo(conf, space, test.Vectors, centroids.Initializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var stats = algo.Stats()
+	var size = 0
+	for i := range stats {
+		size += stats[i].Size
+	}
+	if len(stats) != 3 || size != len(test.Vectors) {
+		t.Error("Expected 3 clusters of", len(test.Vectors), "elements got", stats)
+	}
+}
+
+// roundArray rounds values to 2 decimals since integer weights given to Combine approximate memberships
+func roundArray(values []float64) []float64 {
+	var rounded = make([]float64, len(values))
+	for i := range values {
+		rounded[i] = math.Round(values[i]*100) / 100
+	}
+	return rounded
+}
+
+func roundClust(clust core.Clust) (rounded core.Clust) {
+	for _, c := range clust {
+		rounded = append(rounded, roundArray(c.([]float64)))
+	}
+	return
+}
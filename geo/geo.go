@@ -0,0 +1,114 @@
+// Package geo allows to computes clusters of geographic positions with great-circle distance.
+package geo
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+// EarthRadius is the mean radius of the Earth in kilometers
+const EarthRadius = 6371.0088
+
+// Conf defines the sphere radius
+type Conf struct {
+	Radius float64 // radius of the sphere, EarthRadius if not positive
+}
+
+// Space for positions ([]float64{latitude, longitude} in degrees) with Haversine distance.
+// Distances are expressed in the unit of the radius, kilometers by default.
+type Space struct {
+	radius float64
+	vspace euclid.Space
+}
+
+// NewSpace creates a new Space
+func NewSpace(conf Conf) Space {
+	var radius = conf.Radius
+	if radius <= 0 {
+		radius = EarthRadius
+	}
+	return Space{
+		radius: radius,
+		vspace: euclid.NewSpace(),
+	}
+}
+
+// Radius returns the sphere radius
+func (space Space) Radius() float64 {
+	return space.radius
+}
+
+// Dist computes the great-circle distance between two positions
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return space.PointDist(elemt1.([]float64), elemt2.([]float64))
+}
+
+// IsMetric returns true since the great-circle distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
+// PointDist returns the great-circle distance between two positions using the Haversine formula
+func (space Space) PointDist(point1 []float64, point2 []float64) float64 {
+	var lat1, lon1 = radians(point1)
+	var lat2, lon2 = radians(point2)
+	var sinLat = math.Sin((lat2 - lat1) / 2)
+	var sinLon = math.Sin((lon2 - lon1) / 2)
+	var h = sinLat*sinLat + math.Cos(lat1)*math.Cos(lat2)*sinLon*sinLon
+	return 2 * space.radius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// Combine returns the spherical weighted mean of two positions
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return space.PointCombine(elemt1.([]float64), weight1, elemt2.([]float64), weight2)
+}
+
+// PointCombine returns the spherical weighted mean of two positions, that is the normalized weighted mean
+// of their unit vectors. The position with the greatest weight is returned if positions are antipodal.
+func (space Space) PointCombine(point1 []float64, weight1 int, point2 []float64, weight2 int) []float64 {
+	var x1, y1, z1 = cartesian(point1)
+	var x2, y2, z2 = cartesian(point2)
+	var w1, w2 = float64(weight1), float64(weight2)
+	var x, y, z = w1*x1 + w2*x2, w1*y1 + w2*y2, w1*z1 + w2*z2
+	var norm = math.Sqrt(x*x + y*y + z*z)
+	if norm < 1e-12*(w1+w2) {
+		if weight2 > weight1 {
+			return space.PointCopy(point2)
+		}
+		return space.PointCopy(point1)
+	}
+	var lat = math.Asin(math.Max(-1, math.Min(1, z/norm)))
+	var lon = math.Atan2(y, x)
+	return []float64{lat * 180 / math.Pi, lon * 180 / math.Pi}
+}
+
+// Copy creates a copy of a position
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	return space.vspace.Copy(elemt)
+}
+
+// PointCopy copy positions
+func (space Space) PointCopy(point []float64) []float64 {
+	return space.vspace.PointCopy(point)
+}
+
+// Dim returns the dimension of positions
+func (space Space) Dim(data []core.Elemt) int {
+	return space.vspace.Dim(data)
+}
+
+// Codec returns the position codec for the given format
+func (space Space) Codec(format core.Format) (core.Codec, error) {
+	return space.vspace.Codec(format)
+}
+
+func radians(point []float64) (lat float64, lon float64) {
+	return point[0] * math.Pi / 180, point[1] * math.Pi / 180
+}
+
+func cartesian(point []float64) (x, y, z float64) {
+	var lat, lon = radians(point)
+	return math.Cos(lat) * math.Cos(lon), math.Cos(lat) * math.Sin(lon), math.Sin(lat)
+}
@@ -0,0 +1,96 @@
+package geo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/geo"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/streaming"
+
+	"golang.org/x/exp/rand"
+)
+
+var space = geo.NewSpace(geo.Conf{})
+
+func TestSpace_Dist(t *testing.T) {
+	var paris, london = []float64{48.8566, 2.3522}, []float64{51.5074, -0.1278}
+	if d := space.Dist(paris, london); math.Abs(d-343.5) > 1 {
+		t.Error("Expected 343.5 km got", d)
+	}
+	test.AssertAlmostEqual(t, 0., space.Dist(paris, paris))
+	test.AssertAlmostEqual(t, math.Pi*geo.EarthRadius, space.Dist([]float64{90, 0}, []float64{-90, 0}))
+	test.AssertTrue(t, core.IsMetric(space))
+}
+
+func TestSpace_Antimeridian(t *testing.T) {
+	var east, west = []float64{0, 179}, []float64{0, -179}
+	test.AssertAlmostEqual(t, 2*math.Pi*geo.EarthRadius/180, space.Dist(east, west))
+
+	var mean = space.Combine(east, 1, west, 1).([]float64)
+	test.AssertAlmostEqual(t, 0., mean[0])
+	test.AssertAlmostEqual(t, 180., math.Abs(mean[1]))
+}
+
+func TestSpace_Pole(t *testing.T) {
+	var mean = space.Combine([]float64{80, 0}, 1, []float64{80, 180}, 1).([]float64)
+	test.AssertAlmostEqual(t, 90., mean[0])
+
+	mean = space.Combine([]float64{0, 0}, 1, []float64{0, 90}, 3).([]float64)
+	test.AssertAlmostEqual(t, 0., mean[0])
+	test.AssertAlmostEqual(t, math.Atan(3)*180/math.Pi, mean[1])
+}
+
+func TestSpace_Antipodal(t *testing.T) {
+	var mean = space.Combine([]float64{10, 20}, 1, []float64{-10, -160}, 2).([]float64)
+	test.AssertArrayAlmostEqual(t, []float64{-10, -160}, mean)
+}
+
+func TestSpace_Radius(t *testing.T) {
+	var unit = geo.NewSpace(geo.Conf{Radius: 1})
+	test.AssertAlmostEqual(t, 1., unit.Radius())
+	test.AssertAlmostEqual(t, math.Pi/2, unit.Dist([]float64{0, 0}, []float64{0, 90}))
+}
+
+func positions(rgen *rand.Rand, n int) (data []core.Elemt) {
+	var centers = [][]float64{{0, 179.5}, {89, 0}, {-30, 40}}
+	for i := 0; i < n; i++ {
+		var center = centers[i%3]
+		var lon = center[1] + rgen.Float64() - .5
+		if lon > 180 {
+			lon -= 360
+		}
+		data = append(data, []float64{center[0] + rgen.Float64() - .5, lon})
+	}
+	return
+}
+
+func assertPositions(t *testing.T, algo *core.Algo, data []core.Elemt) {
+	var _, first, _ = algo.Predict(data[0])
+	for i := range data {
+		var _, label, dist = algo.Predict(data[i])
+		if (i%3 == 0) != (label == first) || dist > 200 {
+			t.Error("Expected positions around the same center in the same cluster", data[i], dist)
+		}
+	}
+}
+
+func TestSpace_KMeans(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var data = positions(rgen, 60)
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen}
+	var algo = kmeans.NewAlgo(conf, space, data, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+	assertPositions(t, algo, data)
+}
+
+func TestSpace_Streaming(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var data = positions(rgen, 60)
+	var conf = streaming.Conf{BufferSize: 60, CtrlConf: core.CtrlConf{Iter: 50}, RGen: rgen}
+	var algo = streaming.NewAlgo(conf, space, data)
+	test.AssertNoError(t, algo.Batch())
+	assertPositions(t, algo, data)
+}
@@ -0,0 +1,12 @@
+// Package gmm provides gaussian mixture models implementation of online clustering for vectors ([]float64).
+// Mixtures are fitted with expectation-maximization (EM), centroids being the means of the components.
+package gmm
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new gaussian mixture algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, initializer core.Initializer, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, initializer, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
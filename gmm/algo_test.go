@@ -0,0 +1,67 @@
+package gmm_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/gmm"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+func rgen() *rand.Rand {
+	return rand.New(rand.NewSource(6305689164243))
+}
+
+// blobs returns size vectors drawn from two gaussians:
+// centered on (0, 0) with unit variances and centered on (10, 10) with variances 9 and 1
+func blobs(size int) (data []core.Elemt) {
+	var r = rgen()
+	for i := 0; i < size; i++ {
+		if i%2 == 0 {
+			data = append(data, []float64{r.NormFloat64(), r.NormFloat64()})
+		} else {
+			data = append(data, []float64{10 + 3*r.NormFloat64(), 10 + r.NormFloat64()})
+		}
+	}
+	return
+}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = gmm.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}, RGen: rgen()}
+	var algo = gmm.NewAlgo(conf, space, nil, kmeans.GivenInitializer)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_Initialization(t *testing.T) {
+	var algo = gmm.NewAlgo(gmm.Conf{K: 3}, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestInitialization(t, algo)
+}
+
+func Test_RunSyncGiven(t *testing.T) {
+	var conf = gmm.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	var algo = gmm.NewAlgo(conf, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestRunSyncGiven(t, algo)
+}
+
+func Test_RunSyncPP(t *testing.T) {
+	var conf = gmm.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+	var algo = gmm.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = gmm.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000}, RGen: rgen()}
+	var algo = gmm.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestWorkflow(t, algo)
+}
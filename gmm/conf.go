@@ -0,0 +1,42 @@
+package gmm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of gaussian mixture models
+type Conf struct {
+	core.CtrlConf
+	K         int
+	Full      bool    // full covariance matrices, diagonal ones otherwise
+	Reg       float64 // value added to covariance diagonals for numerical stability, 1e-6 if 0
+	FrameSize int
+	RGen      *rand.Rand
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	if conf.K < 1 {
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	} else if conf.Reg < 0 {
+		err = fmt.Errorf("Illegal value for Reg: %v", conf.Reg)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.Reg == 0 {
+		conf.Reg = 1e-6
+	}
+}
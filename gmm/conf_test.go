@@ -0,0 +1,31 @@
+package gmm_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/gmm"
+)
+
+func TestGMM_ConfErrorK(t *testing.T) {
+	var conf = gmm.Conf{K: -12}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestGMM_ConfErrorReg(t *testing.T) {
+	var conf = gmm.Conf{K: 2, Reg: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestGMM_ConfDefault(t *testing.T) {
+	var conf = gmm.Conf{K: 2}
+	var err = conf.Verify()
+	if err != nil || conf.Reg != 1e-6 || conf.RGen == nil {
+		t.Error("default values expected", err, conf)
+	}
+}
@@ -0,0 +1,6 @@
+package gmm
+
+const (
+	// LogLikelihood is the log likelihood of buffered elements given the mixture
+	LogLikelihood = "logLikelihood"
+)
@@ -0,0 +1,169 @@
+package gmm
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"sync"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Impl algorithm implementation
+type Impl struct {
+	buffer      core.Buffer
+	initializer core.Initializer
+	mu          sync.RWMutex
+	mixture     Mixture
+	gaussians   gaussians
+	stats       []core.ClusterStats
+}
+
+// NewImpl creates a new gaussian mixture implementation
+func NewImpl(conf Conf, initializer core.Initializer, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer:      core.NewDataBuffer(data, conf.FrameSize),
+		initializer: initializer,
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init Algorithm: means are given by the initializer, covariances are the covariance of buffered elements
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	var conf = model.Conf().(*Conf)
+	_ = impl.buffer.Apply()
+	if clust, err = impl.initializer(conf.K, impl.buffer.Data(), model.Space(), conf.RGen); err == nil {
+		err = impl.setMixture(impl.initialMixture(conf, clust))
+	}
+	return
+}
+
+// initialMixture returns components of equal weights centered on given means
+// with the covariance of buffered elements
+func (impl *Impl) initialMixture(conf *Conf, means core.Clust) (mixture Mixture) {
+	var _, _, cov = moments(impl.buffer.Data(), impl.buffer.Weights(), nil, conf.Full, conf.Reg)
+	mixture = make(Mixture, len(means))
+	for k := range mixture {
+		mixture[k] = Component{Weight: 1 / float64(len(means)), Mean: means[k].([]float64), Covariance: cov}
+	}
+	return
+}
+
+// Iterate runs an expectation-maximization step
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+
+	var mixture = impl.Mixture()
+	if len(mixture) != len(model.Centroids()) {
+		mixture = impl.initialMixture(conf, model.Centroids())
+	}
+	var next, logLikelihood, stepErr = mixture.Step(data, weights, conf.Full, conf.Reg)
+	if stepErr != nil {
+		return model.Centroids(), nil, stepErr
+	}
+	if err = impl.setMixture(next); err != nil {
+		return model.Centroids(), nil, err
+	}
+
+	clust = next.Means()
+	var stats = clust.ReduceStats(data, weights, model.Space(), norm)
+	impl.mu.Lock()
+	impl.stats = stats
+	impl.mu.Unlock()
+	runtimeFigures = core.RuntimeFigures{LogLikelihood: logLikelihood}
+	return clust, runtimeFigures, impl.buffer.Apply()
+}
+
+// setMixture replaces the current mixture
+func (impl *Impl) setMixture(mixture Mixture) (err error) {
+	var g gaussians
+	if g, err = newGaussians(mixture); err == nil {
+		impl.mu.Lock()
+		impl.mixture, impl.gaussians = mixture, g
+		impl.mu.Unlock()
+	}
+	return
+}
+
+// Mixture returns the mixture of the last iteration, which must not be modified
+func (impl *Impl) Mixture() Mixture {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.mixture
+}
+
+// Proba returns the membership probabilities of a vector to each component of the current mixture,
+// nil if the algorithm is not initialized
+func (impl *Impl) Proba(elemt core.Elemt) []float64 {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	if len(impl.mixture) == 0 {
+		return nil
+	}
+	return impl.gaussians.proba(elemt.([]float64))
+}
+
+// Stats returns cluster statistics of the last iteration, elements being assigned to the nearest mean
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
+	return impl.buffer.Push(elemt, model.Status().Alive())
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) error {
+	return impl.buffer.PushWeighted(elemt, weight, model.Status().Alive())
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, impl.initializer, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	if mixture := impl.Mixture(); len(mixture) > 0 {
+		_ = newImpl.setMixture(mixture)
+	}
+	return &newImpl, nil
+}
+
+// implState is the persisted form of an Impl
+type implState struct {
+	Buffer  []byte
+	Mixture Mixture
+}
+
+// Save writes buffered data and the current mixture
+func (impl *Impl) Save(w io.Writer) (err error) {
+	var buffer bytes.Buffer
+	if err = impl.buffer.Save(&buffer); err == nil {
+		err = gob.NewEncoder(w).Encode(implState{
+			Buffer:  buffer.Bytes(),
+			Mixture: impl.Mixture(),
+		})
+	}
+	return
+}
+
+// Load replaces buffered data and the current mixture with saved ones
+func (impl *Impl) Load(r io.Reader) (err error) {
+	var state implState
+	if err = gob.NewDecoder(r).Decode(&state); err == nil {
+		err = impl.buffer.Load(bytes.NewReader(state.Buffer))
+	}
+	if err == nil {
+		err = impl.setMixture(state.Mixture)
+	}
+	return
+}
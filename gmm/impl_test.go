@@ -0,0 +1,135 @@
+package gmm_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/gmm"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+func assertClose(t *testing.T, expected float64, actual float64, tolerance float64) {
+	if math.Abs(expected-actual) > tolerance {
+		t.Error("Expected", expected, "got", actual)
+	}
+}
+
+func fit(t *testing.T, full bool) (*core.Algo, gmm.Mixture) {
+	var data = blobs(1000)
+	var conf = gmm.Conf{K: 2, Full: full, CtrlConf: core.CtrlConf{Iter: 30}, RGen: rgen()}
+	var init = core.Clust{[]float64{1, 1}, []float64{8, 8}}
+	var algo = gmm.NewAlgo(conf, space, data, init.Initializer)
+	test.AssertNoError(t, algo.Batch())
+	return algo, algo.Impl().(*gmm.Impl).Mixture()
+}
+
+func TestImpl_Diagonal(t *testing.T) {
+	var _, mixture = fit(t, false)
+
+	assertClose(t, .5, mixture[0].Weight, .01)
+	assertClose(t, 0, mixture[0].Mean[0], .2)
+	assertClose(t, 10, mixture[1].Mean[0], .3)
+	assertClose(t, 1, mixture[0].Covariance[0][0], .2)
+	assertClose(t, 9, mixture[1].Covariance[0][0], 1.5)
+	assertClose(t, 1, mixture[1].Covariance[1][1], .2)
+	test.AssertAlmostEqual(t, 0, mixture[1].Covariance[0][1])
+}
+
+func TestImpl_Full(t *testing.T) {
+	var _, mixture = fit(t, true)
+
+	assertClose(t, 9, mixture[1].Covariance[0][0], 1.5)
+	assertClose(t, 0, mixture[1].Covariance[0][1], .5)
+	test.AssertAlmostEqual(t, mixture[1].Covariance[0][1], mixture[1].Covariance[1][0])
+}
+
+func TestImpl_Proba(t *testing.T) {
+	var algo, _ = fit(t, false)
+	var impl = algo.Impl().(*gmm.Impl)
+
+	var proba = impl.Proba([]float64{0, 0})
+	test.AssertAlmostEqual(t, 1, proba[0]+proba[1])
+	test.AssertTrue(t, proba[0] > .99)
+
+	// far from the first component along the large variance axis of the second one
+	proba = impl.Proba([]float64{2, 10})
+	test.AssertTrue(t, proba[1] > .99)
+
+	var _, label, _ = algo.Predict([]float64{0, 0})
+	test.AssertEqual(t, 0, label)
+
+	var figures = algo.RuntimeFigures()
+	var logLikelihood, ok = figures[gmm.LogLikelihood]
+	test.AssertTrue(t, ok && logLikelihood < 0)
+}
+
+func TestImpl_NotInitialized(t *testing.T) {
+	var conf = gmm.Conf{K: 2}
+	var impl = gmm.NewImpl(conf, kmeans.GivenInitializer, nil)
+	if impl.Proba([]float64{0, 0}) != nil {
+		t.Error("nil expected")
+	}
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = gmm.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	var centroids = core.Clust(test.Vectors[:3])
+	var weighted = gmm.NewAlgo(conf, space, nil, centroids.Initializer)
+	var duplicated = gmm.NewAlgo(conf, space, nil, centroids.Initializer)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, duplicated.Centroids(), weighted.Centroids())
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = gmm.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	var centroids = core.Clust(test.Vectors[:3])
+	var algo = gmm.NewAlgo(conf, space, test.Vectors, centroids.Initializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var stats = algo.Stats()
+	var size = 0
+	for i := range stats {
+		size += stats[i].Size
+	}
+	if len(stats) != 3 || size != len(test.Vectors) {
+		t.Error("Expected 3 clusters of", len(test.Vectors), "elements got", stats)
+	}
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = gmm.Conf{K: 2, Full: true, CtrlConf: core.CtrlConf{Iter: 5}}
+	var init = core.Clust{[]float64{1, 1}, []float64{8, 8}}
+	var algo = gmm.NewAlgo(conf, space, blobs(100), init.Initializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+
+	var impl = gmm.NewImpl(conf, init.Initializer, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	var expected = algo.Impl().(*gmm.Impl).Proba([]float64{5, 5})
+	test.AssertArrayAlmostEqual(t, expected, impl.Proba([]float64{5, 5}))
+
+	test.AssertNoError(t, algo.Batch())
+	test.AssertNoError(t, restored.Batch())
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+}
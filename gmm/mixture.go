@@ -0,0 +1,272 @@
+package gmm
+
+import (
+	"errors"
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrCovariance raised when a covariance matrix is not symmetric positive definite
+var ErrCovariance = errors.New("covariance matrix must be symmetric positive definite")
+
+// Component of a gaussian mixture
+type Component struct {
+	Weight     float64     // mixing weight, weights of a mixture sum to 1
+	Mean       []float64   // mean vector
+	Covariance [][]float64 // covariance matrix, off diagonal values are null for diagonal covariances
+}
+
+// Mixture of gaussian components
+type Mixture []Component
+
+// Means returns the means of the components
+func (m Mixture) Means() (means core.Clust) {
+	means = make(core.Clust, len(m))
+	for k := range m {
+		means[k] = m[k].Mean
+	}
+	return
+}
+
+// Proba returns the membership probabilities of a vector to each component
+func (m Mixture) Proba(elemt core.Elemt) (proba []float64, err error) {
+	var g gaussians
+	if g, err = newGaussians(m); err == nil {
+		proba = g.proba(elemt.([]float64))
+	}
+	return
+}
+
+// LogLikelihood returns the log likelihood of weighted vectors. Nil weights stand for unit weights.
+func (m Mixture) LogLikelihood(data []core.Elemt, weights []int) (logLikelihood float64, err error) {
+	var g gaussians
+	if g, err = newGaussians(m); err == nil {
+		var logp = make([]float64, len(m))
+		for i := range data {
+			logLikelihood += float64(core.Weight(weights, i)) * g.logJoint(data[i].([]float64), logp)
+		}
+	}
+	return
+}
+
+// Step runs one expectation-maximization step over weighted vectors and returns the new mixture
+// and the log likelihood of the vectors given the mixture before the step.
+// Covariances are full if full is true and diagonal otherwise, reg is added to their diagonal.
+// Components without any member are kept with a null weight.
+func (m Mixture) Step(data []core.Elemt, weights []int, full bool, reg float64) (next Mixture, logLikelihood float64, err error) {
+	if len(data) == 0 {
+		return m, 0, nil
+	}
+	var g gaussians
+	if g, err = newGaussians(m); err != nil {
+		return
+	}
+
+	// expectation: responsibilities of components for each vector
+	var resp = make([][]float64, len(m))
+	for k := range resp {
+		resp[k] = make([]float64, len(data))
+	}
+	var logp = make([]float64, len(m))
+	var total = 0.
+	for i := range data {
+		var weight = float64(core.Weight(weights, i))
+		var logSum = g.logJoint(data[i].([]float64), logp)
+		logLikelihood += weight * logSum
+		total += weight
+		for k := range resp {
+			resp[k][i] = math.Exp(logp[k] - logSum)
+		}
+	}
+
+	// maximization: weights, means and covariances given responsibilities
+	next = make(Mixture, len(m))
+	for k := range next {
+		var size, mean, cov = moments(data, weights, resp[k], full, reg)
+		next[k] = Component{Weight: size / total, Mean: mean, Covariance: cov}
+		if size <= 0 || !isPositiveDefinite(cov) {
+			next[k].Mean, next[k].Covariance = m[k].Mean, m[k].Covariance
+		}
+	}
+	return
+}
+
+// moments returns the weight, mean and covariance of weighted vectors given responsibilities.
+// Nil responsibilities stand for unit responsibilities.
+func moments(data []core.Elemt, weights []int, resp []float64, full bool, reg float64) (size float64, mean []float64, cov [][]float64) {
+	if len(data) == 0 {
+		return
+	}
+	var dim = len(data[0].([]float64))
+	var weight = func(i int) float64 {
+		if resp == nil {
+			return float64(core.Weight(weights, i))
+		}
+		return float64(core.Weight(weights, i)) * resp[i]
+	}
+
+	mean = make([]float64, dim)
+	for i := range data {
+		var w = weight(i)
+		size += w
+		for d, x := range data[i].([]float64) {
+			mean[d] += w * x
+		}
+	}
+	if size <= 0 {
+		return
+	}
+	for d := range mean {
+		mean[d] /= size
+	}
+
+	cov = make([][]float64, dim)
+	for d := range cov {
+		cov[d] = make([]float64, dim)
+	}
+	var diff = make([]float64, dim)
+	for i := range data {
+		var w = weight(i)
+		for d, x := range data[i].([]float64) {
+			diff[d] = x - mean[d]
+		}
+		for d := range diff {
+			if full {
+				for e := 0; e <= d; e++ {
+					cov[d][e] += w * diff[d] * diff[e]
+				}
+			} else {
+				cov[d][d] += w * diff[d] * diff[d]
+			}
+		}
+	}
+	for d := range cov {
+		for e := 0; e <= d; e++ {
+			cov[d][e] /= size
+			cov[e][d] = cov[d][e]
+		}
+		cov[d][d] += reg
+	}
+	return
+}
+
+// isPositiveDefinite returns true if the symmetric matrix admits a Cholesky factorization
+func isPositiveDefinite(cov [][]float64) bool {
+	var chol mat.Cholesky
+	return chol.Factorize(symDense(cov))
+}
+
+func symDense(cov [][]float64) *mat.SymDense {
+	var sym = mat.NewSymDense(len(cov), nil)
+	for d := range cov {
+		for e := 0; e <= d; e++ {
+			sym.SetSym(d, e, cov[d][e])
+		}
+	}
+	return sym
+}
+
+// gaussians are mixture components ready for density computations
+type gaussians struct {
+	logWeights []float64
+	means      [][]float64
+	logNorms   []float64       // logarithms of normalization constants
+	chols      []*mat.Cholesky // factorization of full covariances, nil for diagonal ones
+	precisions [][]float64     // inverse of diagonal covariances
+}
+
+func newGaussians(m Mixture) (g gaussians, err error) {
+	g = gaussians{
+		logWeights: make([]float64, len(m)),
+		means:      make([][]float64, len(m)),
+		logNorms:   make([]float64, len(m)),
+		chols:      make([]*mat.Cholesky, len(m)),
+		precisions: make([][]float64, len(m)),
+	}
+	for k := range m {
+		var dim = len(m[k].Mean)
+		if len(m[k].Covariance) != dim {
+			return g, ErrCovariance
+		}
+		g.logWeights[k] = math.Log(m[k].Weight)
+		g.means[k] = m[k].Mean
+		var logDet float64
+		if isDiagonal(m[k].Covariance) {
+			g.precisions[k] = make([]float64, dim)
+			for d := range m[k].Covariance {
+				if m[k].Covariance[d][d] <= 0 {
+					return g, ErrCovariance
+				}
+				g.precisions[k][d] = 1 / m[k].Covariance[d][d]
+				logDet += math.Log(m[k].Covariance[d][d])
+			}
+		} else {
+			g.chols[k] = &mat.Cholesky{}
+			if !g.chols[k].Factorize(symDense(m[k].Covariance)) {
+				return g, ErrCovariance
+			}
+			logDet = g.chols[k].LogDet()
+		}
+		g.logNorms[k] = -.5 * (float64(dim)*math.Log(2*math.Pi) + logDet)
+	}
+	return
+}
+
+func isDiagonal(cov [][]float64) bool {
+	for d := range cov {
+		if len(cov[d]) != len(cov) {
+			return false
+		}
+		for e := range cov[d] {
+			if d != e && cov[d][e] != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// logJoint fills logp with the logarithms of the joint densities of the vector and each component
+// and returns the logarithm of the mixture density
+func (g gaussians) logJoint(x []float64, logp []float64) float64 {
+	var max = math.Inf(-1)
+	var diff = make([]float64, len(x))
+	for k := range logp {
+		for d := range x {
+			diff[d] = x[d] - g.means[k][d]
+		}
+		var maha = 0.
+		if g.chols[k] != nil {
+			var v = mat.NewVecDense(len(diff), diff)
+			var sol mat.VecDense
+			_ = g.chols[k].SolveVecTo(&sol, v)
+			maha = mat.Dot(v, &sol)
+		} else {
+			for d := range diff {
+				maha += diff[d] * diff[d] * g.precisions[k][d]
+			}
+		}
+		logp[k] = g.logWeights[k] + g.logNorms[k] - .5*maha
+		max = math.Max(max, logp[k])
+	}
+	if math.IsInf(max, -1) {
+		return max
+	}
+	var sum = 0.
+	for k := range logp {
+		sum += math.Exp(logp[k] - max)
+	}
+	return max + math.Log(sum)
+}
+
+// proba returns the membership probabilities of the vector to each component
+func (g gaussians) proba(x []float64) (proba []float64) {
+	proba = make([]float64, len(g.means))
+	var logSum = g.logJoint(x, proba)
+	for k := range proba {
+		proba[k] = math.Exp(proba[k] - logSum)
+	}
+	return
+}
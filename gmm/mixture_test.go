@@ -0,0 +1,72 @@
+package gmm_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/gmm"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var mixture = gmm.Mixture{
+	{Weight: .25, Mean: []float64{0, 0}, Covariance: [][]float64{{1, 0}, {0, 1}}},
+	{Weight: .75, Mean: []float64{4, 0}, Covariance: [][]float64{{1, .5}, {.5, 2}}},
+}
+
+func TestMixture_Proba(t *testing.T) {
+	var proba, err = mixture.Proba([]float64{0, 0})
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 1., proba[0]+proba[1])
+	test.AssertTrue(t, proba[0] > .9)
+
+	// density of the full component at (2, 0) is exp(-16/7)/(2π√1.75)
+	proba, _ = mixture.Proba([]float64{2, 0})
+	var p0 = .25 * math.Exp(-2) / (2 * math.Pi)
+	var p1 = .75 * math.Exp(-16./7) / (2 * math.Pi * math.Sqrt(1.75))
+	test.AssertArrayAlmostEqual(t, []float64{p0 / (p0 + p1), p1 / (p0 + p1)}, proba)
+}
+
+func TestMixture_LogLikelihood(t *testing.T) {
+	var data = []core.Elemt{[]float64{0, 0}, []float64{2, 0}}
+	var logLikelihood, err = mixture.LogLikelihood(data, []int{2, 1})
+	test.AssertNoError(t, err)
+
+	var p00 = .25/(2*math.Pi) + .75*math.Exp(-64./7)/(2*math.Pi*math.Sqrt(1.75))
+	var p20 = .25*math.Exp(-2)/(2*math.Pi) + .75*math.Exp(-16./7)/(2*math.Pi*math.Sqrt(1.75))
+	test.AssertAlmostEqual(t, 2*math.Log(p00)+math.Log(p20), logLikelihood)
+}
+
+func TestMixture_Covariance(t *testing.T) {
+	var singular = gmm.Mixture{{Weight: 1, Mean: []float64{0, 0}, Covariance: [][]float64{{1, 1}, {1, 1}}}}
+	var _, err = singular.Proba([]float64{0, 0})
+	test.AssertError(t, err)
+
+	var negative = gmm.Mixture{{Weight: 1, Mean: []float64{0, 0}, Covariance: [][]float64{{1, 0}, {0, -1}}}}
+	_, err = negative.Proba([]float64{0, 0})
+	test.AssertError(t, err)
+}
+
+func TestMixture_Step(t *testing.T) {
+	var data = blobs(300)
+	var current = gmm.Mixture{
+		{Weight: .5, Mean: []float64{1, 1}, Covariance: [][]float64{{10, 0}, {0, 10}}},
+		{Weight: .5, Mean: []float64{8, 8}, Covariance: [][]float64{{10, 0}, {0, 10}}},
+	}
+	var previous = math.Inf(-1)
+	for i := 0; i < 20; i++ {
+		var next, logLikelihood, err = current.Step(data, nil, false, 1e-6)
+		test.AssertNoError(t, err)
+		test.AssertTrue(t, logLikelihood >= previous-1e-9)
+		test.AssertAlmostEqual(t, 0, next[0].Covariance[0][1])
+		current, previous = next, logLikelihood
+	}
+	test.AssertAlmostEqual(t, 1., current[0].Weight+current[1].Weight)
+}
+
+func TestMixture_StepEmpty(t *testing.T) {
+	var next, logLikelihood, err = mixture.Step(nil, nil, true, 1e-6)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 0, logLikelihood)
+	test.AssertCentroids(t, mixture.Means(), next.Means())
+}
@@ -0,0 +1,12 @@
+// Package hclust provides hierarchical agglomerative implementation of online clustering.
+// A dendrogram is built over buffered elements then cut into clusters represented by their average.
+package hclust
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new hierarchical clustering algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
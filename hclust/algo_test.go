@@ -0,0 +1,45 @@
+package hclust_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/hclust"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var space = euclid.Space{}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = hclust.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = hclust.NewAlgo(conf, space, nil)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_RunSyncCentroids(t *testing.T) {
+	for _, linkage := range []hclust.Linkage{hclust.Single, hclust.Complete, hclust.Average, hclust.Ward} {
+		var conf = hclust.Conf{K: 3, Linkage: linkage, CtrlConf: core.CtrlConf{Iter: 1}}
+		var algo = hclust.NewAlgo(conf, space, nil)
+
+		test.DoTestRunSyncPP(t, algo)
+		test.DoTestRunSyncCentroids(t, algo)
+	}
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = hclust.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000}}
+	var algo = hclust.NewAlgo(conf, space, nil)
+
+	test.DoTestWorkflow(t, algo)
+}
+
+func Test_Empty(t *testing.T) {
+	var conf = hclust.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = hclust.NewAlgo(conf, space, nil)
+
+	if err := algo.Batch(); err == nil {
+		t.Error("error expected")
+	}
+}
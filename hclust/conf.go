@@ -0,0 +1,33 @@
+package hclust
+
+import (
+	"fmt"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Conf of hierarchical clustering
+type Conf struct {
+	core.CtrlConf
+	Linkage   Linkage
+	K         int     // number of clusters of the cut, the cut is made at Threshold if 0
+	Threshold float64 // maximal linkage distance of merges applied by the cut when K is 0
+	FrameSize int
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	if conf.K < 0 {
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	} else if conf.K == 0 && conf.Threshold <= 0 {
+		err = fmt.Errorf("Illegal value for Threshold: %v", conf.Threshold)
+	} else if conf.Linkage < Single || conf.Linkage > Ward {
+		err = fmt.Errorf("Illegal value for Linkage: %v", conf.Linkage)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+}
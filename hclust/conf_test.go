@@ -0,0 +1,39 @@
+package hclust_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/hclust"
+)
+
+func TestHClust_ConfErrorK(t *testing.T) {
+	var conf = hclust.Conf{K: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestHClust_ConfErrorThreshold(t *testing.T) {
+	var conf = hclust.Conf{}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestHClust_ConfErrorLinkage(t *testing.T) {
+	var conf = hclust.Conf{K: 2, Linkage: hclust.Linkage(12)}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestHClust_Conf(t *testing.T) {
+	var conf = hclust.Conf{Threshold: 1, Linkage: hclust.Ward}
+	var err = conf.Verify()
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+}
@@ -0,0 +1,240 @@
+package hclust
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Linkage defines the distance between two clusters
+type Linkage int
+
+const (
+	// Single linkage is the smallest distance between elements of two clusters
+	Single Linkage = iota
+	// Complete linkage is the largest distance between elements of two clusters
+	Complete
+	// Average linkage is the weighted average distance between elements of two clusters
+	Average
+	// Ward linkage is the increase of the sum of squared distances to centroids caused by a merge
+	Ward
+)
+
+// String returns the linkage name
+func (linkage Linkage) String() string {
+	switch linkage {
+	case Single:
+		return "single"
+	case Complete:
+		return "complete"
+	case Average:
+		return "average"
+	case Ward:
+		return "ward"
+	}
+	return fmt.Sprintf("Linkage(%d)", int(linkage))
+}
+
+// Merge of two nodes of a dendrogram.
+// Leaves are elements 0 to n-1, node n+i is the cluster created by the i-th merge.
+type Merge struct {
+	Left, Right int
+	Dist        float64 // linkage distance between merged nodes
+	Weight      int     // weight of the created cluster
+}
+
+// Dendrogram is a hierarchy of clusters built by merging nearest clusters, merges being sorted by distance
+type Dendrogram struct {
+	Leaves int
+	Merges []Merge
+}
+
+// NewDendrogram builds the agglomerative hierarchy of weighted elements with the nearest neighbor chain algorithm.
+// Nil weights stand for unit weights.
+func NewDendrogram(elemts []core.Elemt, weights []int, space core.Space, linkage Linkage) (dendrogram Dendrogram) {
+	var size = len(elemts)
+	dendrogram.Leaves = size
+	if size < 2 {
+		return
+	}
+
+	// dists[i][j] with j < i, squared for Ward linkage
+	var dists = make([][]float64, size)
+	for i := range dists {
+		dists[i] = make([]float64, i)
+		for j := range dists[i] {
+			dists[i][j] = space.Dist(elemts[i], elemts[j])
+			if linkage == Ward {
+				dists[i][j] *= dists[i][j]
+			}
+		}
+	}
+	var dist = func(i, j int) float64 {
+		if i > j {
+			return dists[i][j]
+		}
+		return dists[j][i]
+	}
+	var setDist = func(i, j int, d float64) {
+		if i > j {
+			dists[i][j] = d
+		} else {
+			dists[j][i] = d
+		}
+	}
+
+	var active = make([]bool, size)
+	var clusterWeights = make([]int, size)
+	for i := range active {
+		active[i] = true
+		clusterWeights[i] = core.Weight(weights, i)
+	}
+
+	// merges are found in any order and refer to surviving slots
+	type slotMerge struct {
+		a, b int
+		dist float64
+	}
+	var merges = make([]slotMerge, 0, size-1)
+	var chain []int
+	for len(merges) < size-1 {
+		if len(chain) == 0 {
+			for i := range active {
+				if active[i] {
+					chain = append(chain, i)
+					break
+				}
+			}
+		}
+		var current = chain[len(chain)-1]
+		var previous = -1
+		if len(chain) > 1 {
+			previous = chain[len(chain)-2]
+		}
+		// nearest neighbor of the chain head, preferring the previous element on ties
+		var nearest, nearestDist = previous, math.Inf(1)
+		if previous >= 0 {
+			nearestDist = dist(current, previous)
+		}
+		for j := range active {
+			if active[j] && j != current {
+				if d := dist(current, j); d < nearestDist {
+					nearest, nearestDist = j, d
+				}
+			}
+		}
+		if nearest != previous {
+			chain = append(chain, nearest)
+			continue
+		}
+
+		// reciprocal nearest neighbors are merged into the slot of the first one
+		chain = chain[:len(chain)-2]
+		var a, b = current, previous
+		var wa, wb = float64(clusterWeights[a]), float64(clusterWeights[b])
+		for k := range active {
+			if !active[k] || k == a || k == b {
+				continue
+			}
+			var wk = float64(clusterWeights[k])
+			var dka, dkb = dist(k, a), dist(k, b)
+			var d float64
+			switch linkage {
+			case Single:
+				d = math.Min(dka, dkb)
+			case Complete:
+				d = math.Max(dka, dkb)
+			case Average:
+				d = (wa*dka + wb*dkb) / (wa + wb)
+			case Ward:
+				d = ((wa+wk)*dka + (wb+wk)*dkb - wk*nearestDist) / (wa + wb + wk)
+			}
+			setDist(k, a, d)
+		}
+		active[b] = false
+		clusterWeights[a] += clusterWeights[b]
+		merges = append(merges, slotMerge{a, b, nearestDist})
+	}
+
+	// sort merges and replace slots by nodes
+	sort.SliceStable(merges, func(i, j int) bool { return merges[i].dist < merges[j].dist })
+	var parents = make([]int, size)
+	var nodes = make([]int, size)
+	var nodeWeights = make([]int, size)
+	for i := range parents {
+		parents[i], nodes[i], nodeWeights[i] = i, i, core.Weight(weights, i)
+	}
+	var find = func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+	dendrogram.Merges = make([]Merge, len(merges))
+	for m, merge := range merges {
+		var a, b = find(merge.a), find(merge.b)
+		var d = merge.dist
+		if linkage == Ward {
+			d = math.Sqrt(math.Max(d, 0))
+		}
+		var weight = nodeWeights[a] + nodeWeights[b]
+		dendrogram.Merges[m] = Merge{Left: nodes[a], Right: nodes[b], Dist: d, Weight: weight}
+		parents[b] = a
+		nodes[a], nodeWeights[a] = size+m, weight
+	}
+	return
+}
+
+// Cut returns the labels of elements when the hierarchy is cut into k clusters
+// (less if there are less elements). Clusters are labeled in the order of their first element.
+func (dendrogram Dendrogram) Cut(k int) (labels []int, clusters int) {
+	var merges = dendrogram.Leaves - k
+	if merges < 0 {
+		merges = 0
+	}
+	return dendrogram.labels(merges)
+}
+
+// CutDist returns the labels of elements when the hierarchy is cut at the given distance:
+// only merges at a distance lower than or equal to threshold are applied.
+func (dendrogram Dendrogram) CutDist(threshold float64) (labels []int, clusters int) {
+	var merges = sort.Search(len(dendrogram.Merges), func(i int) bool { return dendrogram.Merges[i].Dist > threshold })
+	return dendrogram.labels(merges)
+}
+
+// labels returns the labels of elements after the given number of merges
+func (dendrogram Dendrogram) labels(merges int) (labels []int, clusters int) {
+	var size = dendrogram.Leaves
+	var parents = make([]int, size+merges)
+	for i := range parents {
+		parents[i] = i
+	}
+	for m := 0; m < merges; m++ {
+		var merge = dendrogram.Merges[m]
+		parents[merge.Left], parents[merge.Right] = size+m, size+m
+	}
+	var find = func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+
+	labels = make([]int, size)
+	var clusterLabels = map[int]int{}
+	for i := range labels {
+		var root = find(i)
+		var label, ok = clusterLabels[root]
+		if !ok {
+			label = clusters
+			clusterLabels[root] = label
+			clusters++
+		}
+		labels[i] = label
+	}
+	return
+}
@@ -0,0 +1,101 @@
+package hclust_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/hclust"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var points = []core.Elemt{[]float64{0}, []float64{7}, []float64{1}, []float64{3}}
+
+func assertMerges(t *testing.T, expected []hclust.Merge, actual []hclust.Merge) {
+	if len(expected) != len(actual) {
+		t.Error("Expected", expected, "got", actual)
+		return
+	}
+	for i := range expected {
+		var e, a = expected[i], actual[i]
+		var sameNodes = e.Left == a.Left && e.Right == a.Right || e.Left == a.Right && e.Right == a.Left
+		if !sameNodes || e.Weight != a.Weight || math.Abs(e.Dist-a.Dist) > 1e-6 {
+			t.Error("Expected", expected, "got", actual)
+			return
+		}
+	}
+}
+
+func TestDendrogram_Single(t *testing.T) {
+	var dendrogram = hclust.NewDendrogram(points, nil, space, hclust.Single)
+	test.AssertEqual(t, 4, dendrogram.Leaves)
+	assertMerges(t, []hclust.Merge{{0, 2, 1, 2}, {4, 3, 2, 3}, {5, 1, 4, 4}}, dendrogram.Merges)
+}
+
+func TestDendrogram_Complete(t *testing.T) {
+	var dendrogram = hclust.NewDendrogram(points, nil, space, hclust.Complete)
+	assertMerges(t, []hclust.Merge{{0, 2, 1, 2}, {4, 3, 3, 3}, {5, 1, 7, 4}}, dendrogram.Merges)
+}
+
+func TestDendrogram_Average(t *testing.T) {
+	var dendrogram = hclust.NewDendrogram(points, nil, space, hclust.Average)
+	assertMerges(t, []hclust.Merge{{0, 2, 1, 2}, {4, 3, 2.5, 3}, {5, 1, 17. / 3, 4}}, dendrogram.Merges)
+}
+
+func TestDendrogram_Ward(t *testing.T) {
+	// merge distances are sqrt(2 na nb / (na + nb)) times the distance between centroids
+	var dendrogram = hclust.NewDendrogram(points, nil, space, hclust.Ward)
+	var expected = []hclust.Merge{
+		{0, 2, 1, 2},
+		{4, 3, math.Sqrt(4. / 3 * 2.5 * 2.5), 3},
+		{5, 1, math.Sqrt(1.5 * 17. / 3 * 17. / 3), 4},
+	}
+	assertMerges(t, expected, dendrogram.Merges)
+}
+
+func TestDendrogram_Weights(t *testing.T) {
+	var weighted = hclust.NewDendrogram(points, []int{1, 1, 2, 1}, space, hclust.Average)
+	var duplicated = hclust.NewDendrogram(append(points, []float64{1}), nil, space, hclust.Average)
+
+	var last = duplicated.Merges[len(duplicated.Merges)-1]
+	var weightedLast = weighted.Merges[len(weighted.Merges)-1]
+	test.AssertAlmostEqual(t, last.Dist, weightedLast.Dist)
+	test.AssertEqual(t, 5, weightedLast.Weight)
+}
+
+func TestDendrogram_Cut(t *testing.T) {
+	var dendrogram = hclust.NewDendrogram(points, nil, space, hclust.Single)
+
+	var labels, k = dendrogram.Cut(2)
+	test.AssertEqual(t, 2, k)
+	test.AssertArrayEqual(t, []int{0, 1, 0, 0}, labels)
+
+	labels, k = dendrogram.Cut(10)
+	test.AssertEqual(t, 4, k)
+	test.AssertArrayEqual(t, []int{0, 1, 2, 3}, labels)
+
+	labels, k = dendrogram.CutDist(1.5)
+	test.AssertEqual(t, 3, k)
+	test.AssertArrayEqual(t, []int{0, 1, 0, 2}, labels)
+
+	labels, k = dendrogram.CutDist(10)
+	test.AssertEqual(t, 1, k)
+	test.AssertArrayEqual(t, []int{0, 0, 0, 0}, labels)
+}
+
+func TestDendrogram_Empty(t *testing.T) {
+	var dendrogram = hclust.NewDendrogram(nil, nil, space, hclust.Ward)
+	var labels, k = dendrogram.Cut(2)
+	test.AssertEqual(t, 0, k)
+	test.AssertEqual(t, 0, len(labels))
+
+	dendrogram = hclust.NewDendrogram(points[:1], nil, space, hclust.Ward)
+	labels, k = dendrogram.CutDist(1)
+	test.AssertEqual(t, 1, k)
+	test.AssertArrayEqual(t, []int{0}, labels)
+}
+
+func TestLinkage_String(t *testing.T) {
+	test.AssertEqual(t, "ward", hclust.Ward.String())
+	test.AssertEqual(t, "Linkage(12)", hclust.Linkage(12).String())
+}
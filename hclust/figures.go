@@ -0,0 +1,8 @@
+package hclust
+
+const (
+	// Clusters is the number of clusters of the cut
+	Clusters = "clusters"
+	// Height is the linkage distance of the last merge of the hierarchy
+	Height = "height"
+)
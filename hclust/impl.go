@@ -0,0 +1,177 @@
+package hclust
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Impl algorithm implementation.
+// The dendrogram is built again only when elements have been pushed since the last iteration.
+type Impl struct {
+	buffer     core.Buffer
+	pushed     int64 // number of buffered elements, accessed atomically
+	applied    int64 // number of buffered elements when the dendrogram was last built
+	computed   bool  // dendrogram is up to date with buffered elements
+	mu         sync.RWMutex
+	dendrogram Dendrogram
+	labels     []int
+	stats      []core.ClusterStats
+}
+
+// NewImpl creates a new hierarchical clustering implementation
+func NewImpl(conf Conf, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer: core.NewDataBuffer(data, conf.FrameSize),
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	impl.applied = atomic.LoadInt64(&impl.pushed)
+	impl.computed = false
+	_ = impl.buffer.Apply()
+	if len(impl.buffer.Data()) == 0 {
+		err = errors.New("at least one element is needed")
+		return
+	}
+	clust, _ = impl.compute(model)
+	return
+}
+
+// Iterate builds the dendrogram again if elements have been pushed since the last iteration and cuts it
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var pushed = atomic.LoadInt64(&impl.pushed)
+	err = impl.buffer.Apply()
+	if pushed != impl.applied {
+		impl.applied = pushed
+		impl.computed = false
+	}
+	clust, runtimeFigures = impl.compute(model)
+	return
+}
+
+// compute builds the dendrogram if needed and cuts it according to the configuration
+func (impl *Impl) compute(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+	var space = model.Space()
+
+	var dendrogram = impl.Dendrogram()
+	if !impl.computed {
+		dendrogram = NewDendrogram(data, weights, space, conf.Linkage)
+		impl.computed = true
+	}
+
+	var labels, k = impl.cut(conf, dendrogram)
+	clust = Centroids(k, data, weights, labels, space)
+	var stats = make([]core.ClusterStats, k)
+	for i, label := range labels {
+		core.AddToStats(&stats[label], core.Weight(weights, i), space.Dist(data[i], clust[label]), norm)
+	}
+
+	impl.mu.Lock()
+	impl.dendrogram, impl.labels, impl.stats = dendrogram, labels, stats
+	impl.mu.Unlock()
+
+	var height = 0.
+	if len(dendrogram.Merges) > 0 {
+		height = dendrogram.Merges[len(dendrogram.Merges)-1].Dist
+	}
+	runtimeFigures = core.RuntimeFigures{
+		Clusters: float64(k),
+		Height:   height,
+	}
+	return
+}
+
+func (impl *Impl) cut(conf *Conf, dendrogram Dendrogram) (labels []int, k int) {
+	if conf.K > 0 {
+		return dendrogram.Cut(conf.K)
+	}
+	return dendrogram.CutDist(conf.Threshold)
+}
+
+// Centroids returns the weighted average of each of the k clusters given element labels.
+// Nil weights stand for unit weights.
+func Centroids(k int, data []core.Elemt, weights []int, labels []int, space core.Space) (clust core.Clust) {
+	var members = make([][]core.Elemt, k)
+	var memberWeights = make([][]int, k)
+	for i, label := range labels {
+		members[label] = append(members[label], data[i])
+		memberWeights[label] = append(memberWeights[label], core.Weight(weights, i))
+	}
+	clust = make(core.Clust, k)
+	for label := range clust {
+		clust[label], _ = core.WeightedDBA(members[label], memberWeights[label], space)
+	}
+	return
+}
+
+// Dendrogram returns the dendrogram of buffered elements built by the last iteration
+func (impl *Impl) Dendrogram() Dendrogram {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.dendrogram
+}
+
+// Labels returns the labels of buffered elements given by the last cut
+func (impl *Impl) Labels() []int {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	var labels = make([]int, len(impl.labels))
+	copy(labels, impl.labels)
+	return labels
+}
+
+// Stats returns cluster statistics of the last iteration
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) (err error) {
+	if err = impl.buffer.Push(elemt, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) (err error) {
+	if err = impl.buffer.PushWeighted(elemt, weight, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	impl.computed = false
+	return impl.buffer.Load(r)
+}
@@ -0,0 +1,88 @@
+package hclust_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/hclust"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+func TestImpl_Threshold(t *testing.T) {
+	var conf = hclust.Conf{Threshold: 1.5, Linkage: hclust.Complete, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = hclust.NewAlgo(conf, space, points)
+	test.AssertNoError(t, algo.Batch())
+
+	test.AssertCentroids(t, core.Clust{[]float64{.5}, []float64{7}, []float64{3}}, algo.Centroids())
+	var impl = algo.Impl().(*hclust.Impl)
+	test.AssertArrayEqual(t, []int{0, 1, 0, 2}, impl.Labels())
+	test.AssertEqual(t, 3, len(impl.Dendrogram().Merges))
+
+	var figures = algo.RuntimeFigures()
+	test.AssertEqual(t, 3., figures[hclust.Clusters])
+	test.AssertEqual(t, 7., figures[hclust.Height])
+}
+
+func TestImpl_Push(t *testing.T) {
+	var conf = hclust.Conf{K: 2, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = hclust.NewAlgo(conf, space, points)
+	test.AssertNoError(t, algo.Batch())
+	test.AssertCentroids(t, core.Clust{[]float64{4. / 3}, []float64{7}}, algo.Centroids())
+
+	test.AssertNoError(t, algo.Push([]float64{20}))
+	test.AssertNoError(t, algo.Batch())
+	test.AssertCentroids(t, core.Clust{[]float64{11. / 4}, []float64{20}}, algo.Centroids())
+
+	var _, label, _ = algo.Predict([]float64{18})
+	test.AssertEqual(t, 1, label)
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = hclust.Conf{K: 3, Linkage: hclust.Average, CtrlConf: core.CtrlConf{Iter: 1}}
+	var weighted = hclust.NewAlgo(conf, space, nil)
+	var duplicated = hclust.NewAlgo(conf, space, nil)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, duplicated.Centroids(), weighted.Centroids())
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = hclust.Conf{K: 2, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = hclust.NewAlgo(conf, space, points)
+	test.AssertNoError(t, algo.Batch())
+
+	var stats = algo.Stats()
+	test.AssertEqual(t, 2, len(stats))
+	test.AssertEqual(t, 3, stats[0].Size)
+	test.AssertAlmostEqual(t, 16./9+1./9+25./9, stats[0].Loss)
+	test.AssertEqual(t, 1, stats[1].Size)
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = hclust.Conf{K: 2, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = hclust.NewAlgo(conf, space, points)
+	test.AssertNoError(t, algo.Batch())
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+	var impl = hclust.NewImpl(conf, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	test.AssertNoError(t, restored.Batch())
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+}
@@ -0,0 +1,40 @@
+package kmeans
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// BIC returns the bayesian information criterion of weighted elements
+// modeled by spherical gaussians centered on centroids with a common variance estimated from the loss.
+// The dimension is the length of []float64 elements, 1 for other elements.
+// It is NaN if there are no more elements than centroids. Nil weights stand for unit weights.
+func BIC(centroids core.Clust, elemts []core.Elemt, weights []int, space core.Space) float64 {
+	var stats = centroids.ReduceStats(elemts, weights, space, norm)
+	var n, k = 0., float64(len(centroids))
+	var sse = 0.
+	for _, s := range stats {
+		n += float64(s.Size)
+		sse += s.Loss
+	}
+	if n <= k {
+		return math.NaN()
+	}
+	var dim = 1.
+	if vector, ok := elemts[0].([]float64); ok {
+		dim = float64(len(vector))
+	}
+	var variance = sse / (dim * (n - k))
+	if variance == 0 {
+		return math.Inf(1)
+	}
+	var likelihood = -n*dim/2*math.Log(2*math.Pi*variance) - dim*(n-k)/2
+	for _, s := range stats {
+		if s.Size > 0 {
+			likelihood += float64(s.Size) * math.Log(float64(s.Size)/n)
+		}
+	}
+	var params = k*dim + k
+	return likelihood - params/2*math.Log(n)
+}
@@ -0,0 +1,21 @@
+package kmeans_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+func TestBIC(t *testing.T) {
+	var line = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}, []float64{11}}
+	var one = kmeans.BIC(core.Clust{[]float64{5.5}}, line, nil, space)
+	var two = kmeans.BIC(core.Clust{[]float64{.5}, []float64{10.5}}, line, nil, space)
+	test.AssertTrue(t, two > one)
+
+	var weighted = kmeans.BIC(core.Clust{[]float64{.5}, []float64{10.5}}, line[:2], []int{2, 3}, space)
+	test.AssertTrue(t, !math.IsNaN(weighted))
+	test.AssertTrue(t, math.IsNaN(kmeans.BIC(core.Clust{[]float64{0}, []float64{1}}, line[:2], nil, space)))
+}
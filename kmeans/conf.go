@@ -13,11 +13,13 @@ import (
 // Conf of KMeans
 type Conf struct {
 	core.CtrlConf
-	Par       bool
-	K         int
-	FrameSize int
-	RGen      *rand.Rand
-	NumCPU    int // maximal number of CPU to use
+	Par        bool
+	K          int
+	FrameSize  int
+	RGen       *rand.Rand
+	NumCPU     int  // maximal number of CPU to use
+	MiniBatch  int  // number of elements sampled at each iteration, all buffered elements if 0
+	Accelerate bool // keep distance bounds across iterations to skip distance computations in metric spaces
 }
 
 // Verify configuratio
@@ -25,6 +27,8 @@ func (conf *Conf) Verify() (err error) {
 	conf.SetDefaultValues()
 	if conf.K < 1 {
 		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	} else if conf.MiniBatch < 0 {
+		err = fmt.Errorf("Illegal value for MiniBatch: %v", conf.MiniBatch)
 	}
 	return
 }
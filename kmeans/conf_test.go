@@ -22,3 +22,11 @@ func TestKMeans_ConfErrorNumCPU(t *testing.T) {
 		t.Error("0 CPU. Positive expected")
 	}
 }
+
+func TestKMeans_ConfErrorMiniBatch(t *testing.T) {
+	var conf = kmeans.Conf{K: 1, MiniBatch: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
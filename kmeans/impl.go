@@ -1,6 +1,9 @@
 package kmeans
 
 import (
+	"io"
+	"sync/atomic"
+
 	"github.com/wearelumenai/distclus/core"
 )
 
@@ -9,13 +12,28 @@ type Impl struct {
 	strategy    Strategy
 	buffer      core.Buffer
 	initializer core.Initializer
+	bounds      *core.Bounds
+	frameSize   int
+	pushed      int64 // number of elements whose push has started, accessed atomically
+	staged      int64 // number of elements whose push has ended, accessed atomically
+	applied     int64 // number of elements whose push had ended before the last application of the buffer
 }
 
-// Strategy Abstract Impl strategy to be implemented by concrete algorithms
+// Strategy Abstract Impl strategy to be implemented by concrete algorithms.
+// Weights may be nil for unit weights.
 type Strategy interface {
-	Iterate(space core.Space, centroids core.Clust, data []core.Elemt) core.Clust
+	Iterate(space core.Space, centroids core.Clust, data []core.Elemt, weights []int) core.Clust
+}
+
+// StatsStrategy is a strategy that computes cluster statistics while iterating
+type StatsStrategy interface {
+	Strategy
+	Stats() []core.ClusterStats // statistics of the last iteration
 }
 
+// norm of the losses in cluster statistics
+const norm = 2.
+
 // Init Algorithm
 func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
 	var kmeansConf = model.Conf().(*Conf)
@@ -25,19 +43,70 @@ func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
 
 // Iterate the algorithm until signal received on closing channel or iteration number is reached
 func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
-	return impl.strategy.Iterate(model.Space(), model.Centroids(), impl.buffer.Data()),
-		nil,
-		impl.buffer.Apply()
+	impl.checkBounds() // elements stored while the algorithm was not running
+	clust = impl.strategy.Iterate(model.Space(), model.Centroids(), impl.buffer.Data(), impl.buffer.Weights())
+	var staged = atomic.LoadInt64(&impl.staged)
+	err = impl.buffer.Apply()
+	impl.checkBounds()
+	impl.applied = staged
+	return
+}
+
+// Stats returns cluster statistics of the last iteration if the strategy computes them
+func (impl *Impl) Stats() []core.ClusterStats {
+	if strategy, ok := impl.strategy.(StatsStrategy); ok {
+		return strategy.Stats()
+	}
+	return nil
 }
 
 // Push input element in the buffer
 func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
+	atomic.AddInt64(&impl.pushed, 1)
+	defer atomic.AddInt64(&impl.staged, 1)
 	return impl.buffer.Push(elemt, model.Status().Alive())
 }
 
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) error {
+	atomic.AddInt64(&impl.pushed, 1)
+	defer atomic.AddInt64(&impl.staged, 1)
+	return impl.buffer.PushWeighted(elemt, weight, model.Status().Alive())
+}
+
+// checkBounds resets distance bounds if buffered elements may have been replaced since the last application
+// of the buffer. Elements are counted as pushed before being buffered and as staged after, so that elements
+// whose push was in progress during an application are still considered at the next check.
+func (impl *Impl) checkBounds() {
+	if impl.bounds == nil || impl.frameSize <= 0 {
+		return
+	}
+	if atomic.LoadInt64(&impl.pushed) != impl.applied {
+		impl.bounds.Reset()
+	}
+}
+
 // Copy impl
 func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
 	var newConf = model.Conf().(*Conf)
-	var algo = NewAlgo(*newConf, model.Space(), impl.buffer.Data(), impl.initializer)
-	return algo.Impl(), nil
+	var algo = NewAlgo(*newConf, model.Space(), nil, impl.initializer)
+	var newImpl = algo.Impl().(*Impl)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	if impl.bounds != nil {
+		impl.bounds.Reset()
+	}
+	return impl.buffer.Load(r)
 }
@@ -0,0 +1,111 @@
+package kmeans_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+	var algo = kmeans.NewAlgo(conf, space, test.Vectors, kmeans.GivenInitializer)
+	_ = algo.Init()
+
+	var buffer bytes.Buffer
+	var err = algo.Snapshot(&buffer)
+	test.AssertNoError(t, err)
+
+	var impl = kmeans.NewSeqImpl(conf, kmeans.GivenInitializer, nil)
+	restored, err := core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+
+	_ = algo.Play()
+	_ = algo.Wait(nil, 0)
+	_ = restored.Play()
+	_ = restored.Wait(nil, 0)
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+	var centroids = core.Clust(test.Vectors[:3])
+	var initializer = centroids.Initializer
+	var weighted = kmeans.NewAlgo(conf, space, nil, initializer)
+	var duplicated = kmeans.NewAlgo(conf, space, nil, initializer)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, duplicated.Centroids(), weighted.Centroids())
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Stats(t *testing.T) {
+	for _, conf := range []kmeans.Conf{{K: 3}, {K: 3, Par: true}, {K: 3, MiniBatch: 8}} {
+		conf.CtrlConf = core.CtrlConf{Iter: 10}
+		conf.RGen = rgen()
+		var algo = kmeans.NewAlgo(conf, space, test.Vectors, kmeans.PPInitializer)
+		test.AssertNoError(t, algo.Batch())
+
+		var stats = algo.Stats()
+		var size int
+		for i := range stats {
+			size += stats[i].Size
+		}
+		if len(stats) != 3 || size != len(test.Vectors) {
+			t.Error("Expected 3 clusters of", len(test.Vectors), "elements got", stats)
+		}
+	}
+}
+
+// runningModel is a model whose algorithm is running, so that pushed elements are staged
+type runningModel struct {
+	conf      core.Conf
+	centroids core.Clust
+}
+
+func (model *runningModel) Centroids() core.Clust               { return model.centroids }
+func (model *runningModel) Conf() core.Conf                     { return model.conf }
+func (model *runningModel) Impl() core.Impl                     { return nil }
+func (model *runningModel) Space() core.Space                   { return space }
+func (model *runningModel) Status() core.OCStatus               { return core.NewOCStatus(core.Running) }
+func (model *runningModel) RuntimeFigures() core.RuntimeFigures { return nil }
+func (model *runningModel) Stats() []core.ClusterStats          { return nil }
+
+func TestImpl_AccelerateFrame(t *testing.T) {
+	var data = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}, []float64{11}}
+	for _, accelerate := range []bool{false, true} {
+		var conf = kmeans.Conf{K: 2, FrameSize: 4, Accelerate: accelerate, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+		var centroids = core.Clust{[]float64{0}, []float64{10}}
+		var initializer = centroids.Initializer
+		var impl = kmeans.NewSeqImpl(conf, initializer, data)
+		var model = &runningModel{conf: &conf}
+		var err error
+		model.centroids, err = impl.Init(model)
+		test.AssertNoError(t, err)
+
+		model.centroids, _, err = impl.Iterate(model)
+		test.AssertNoError(t, err)
+		test.AssertNoError(t, impl.Push([]float64{10}, model))
+		for i := 0; i < 2; i++ {
+			model.centroids, _, err = impl.Iterate(model)
+			test.AssertNoError(t, err)
+		}
+
+		test.AssertCentroids(t, core.Clust{[]float64{1}, []float64{31. / 3}}, model.centroids)
+	}
+}
@@ -0,0 +1,48 @@
+package kmeans
+
+import "github.com/wearelumenai/distclus/core"
+
+// MedianSpace is implemented by spaces whose distance is minimized by medians rather than by combinations,
+// such as manhattan.Space. Sequential and parallel strategies then update centroids with medians (k-medians).
+type MedianSpace interface {
+	core.Space
+	// weighted median of at least one element, nil weights standing for unit weights
+	Median(elemts []core.Elemt, weights []int) core.Elemt
+}
+
+// reduceMedians assigns elements to centroids, with bounds if not nil, and returns the median and statistics
+// of each cluster. Medians of empty clusters are the given centroids.
+func reduceMedians(centroids core.Clust, data []core.Elemt, weights []int, bounds *core.Bounds, space MedianSpace, degree int) (result core.Clust, stats []core.ClusterStats) {
+	var labels []int
+	var dists []float64
+	switch {
+	case bounds != nil && degree > 1:
+		labels, dists = bounds.ParMapLabel(centroids, data, space, degree)
+	case bounds != nil:
+		labels, dists = bounds.MapLabel(centroids, data, space)
+	case degree > 1:
+		labels, dists = centroids.ParMapLabel(data, space, degree)
+	default:
+		labels, dists = centroids.MapLabel(data, space)
+	}
+
+	var members = make([][]core.Elemt, len(centroids))
+	var memberWeights = make([][]int, len(centroids))
+	stats = make([]core.ClusterStats, len(centroids))
+	for i, label := range labels {
+		var weight = core.Weight(weights, i)
+		members[label] = append(members[label], data[i])
+		memberWeights[label] = append(memberWeights[label], weight)
+		core.AddToStats(&stats[label], weight, dists[i], norm)
+	}
+
+	result = make(core.Clust, len(centroids))
+	for j := range result {
+		if len(members[j]) > 0 {
+			result[j] = space.Median(members[j], memberWeights[j])
+		} else {
+			result[j] = centroids[j]
+		}
+	}
+	return
+}
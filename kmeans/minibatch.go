@@ -0,0 +1,80 @@
+package kmeans
+
+import (
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// NewMiniBatchStrategy returns a mini-batch strategy sampling conf.MiniBatch elements per iteration,
+// assigned in parallel if conf.Par is true
+func NewMiniBatchStrategy(conf Conf) *MiniBatchStrategy {
+	conf.SetDefaultValues()
+	var degree = 1
+	if conf.Par {
+		degree = conf.NumCPU
+	}
+	return &MiniBatchStrategy{
+		Size:   conf.MiniBatch,
+		Degree: degree,
+		RGen:   conf.RGen,
+	}
+}
+
+// MiniBatchStrategy updates centroids with a sample of the data at each iteration.
+// Each centroid moves toward its sampled elements with a learning rate inverse to its cardinality.
+// Sampled elements contribute with their weight.
+type MiniBatchStrategy struct {
+	Size   int        // number of sampled elements per iteration
+	Degree int        // degree of parallelism of the assignment
+	RGen   *rand.Rand // random generator of the samples
+	cards  []int
+	stats  []core.ClusterStats
+}
+
+// Iterate processes input cluster
+func (strategy *MiniBatchStrategy) Iterate(space core.Space, centroids core.Clust, data []core.Elemt, weights []int) core.Clust {
+	if len(data) == 0 {
+		return centroids
+	}
+	if len(strategy.cards) != len(centroids) {
+		strategy.cards = make([]int, len(centroids))
+	}
+
+	var batch, indices = strategy.sample(data)
+	var labels []int
+	var dists []float64
+	if strategy.Degree > 1 {
+		labels, dists = centroids.ParMapLabel(batch, space, strategy.Degree)
+	} else {
+		labels, dists = centroids.MapLabel(batch, space)
+	}
+
+	var result = make(core.Clust, len(centroids))
+	copy(result, centroids)
+	strategy.stats = make([]core.ClusterStats, len(centroids))
+	for i, elemt := range batch {
+		var label = labels[i]
+		var weight = core.Weight(weights, indices[i])
+		result[label] = space.Combine(result[label], strategy.cards[label], elemt, weight)
+		strategy.cards[label] += weight
+		core.AddToStats(&strategy.stats[label], weight, dists[i], norm)
+	}
+	return result
+}
+
+// Stats returns cluster statistics of the last sample, distances being computed before centroids update
+func (strategy *MiniBatchStrategy) Stats() []core.ClusterStats {
+	return strategy.stats
+}
+
+// sample draws elements with replacement and returns their indices
+func (strategy *MiniBatchStrategy) sample(data []core.Elemt) (batch []core.Elemt, indices []int) {
+	batch = make([]core.Elemt, strategy.Size)
+	indices = make([]int, strategy.Size)
+	for i := range batch {
+		indices[i] = strategy.RGen.Intn(len(data))
+		batch[i] = data[indices[i]]
+	}
+	return
+}
@@ -0,0 +1,41 @@
+package kmeans_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+func TestMiniBatchStrategy_Iterate(t *testing.T) {
+	var strategy = kmeans.NewMiniBatchStrategy(kmeans.Conf{K: 2, MiniBatch: 2, RGen: rgen()})
+	var centroids = core.Clust{[]float64{0.}, []float64{10.}}
+
+	var result = strategy.Iterate(space, centroids, []core.Elemt{[]float64{2.}}, nil)
+	test.AssertCentroids(t, core.Clust{[]float64{2.}, []float64{10.}}, result)
+
+	result = strategy.Iterate(space, result, []core.Elemt{[]float64{4.}}, nil)
+	test.AssertCentroids(t, core.Clust{[]float64{3.}, []float64{10.}}, result)
+	test.AssertCentroids(t, core.Clust{[]float64{0.}, []float64{10.}}, centroids)
+}
+
+func TestMiniBatchStrategy_Empty(t *testing.T) {
+	var strategy = kmeans.NewMiniBatchStrategy(kmeans.Conf{K: 1, MiniBatch: 2})
+	var centroids = core.Clust{[]float64{0.}}
+	test.AssertCentroids(t, centroids, strategy.Iterate(space, centroids, nil, nil))
+}
+
+func Test_MiniBatchRunSyncPP(t *testing.T) {
+	var implConf = kmeans.Conf{K: 3, MiniBatch: 4, CtrlConf: core.CtrlConf{Iter: 50}, RGen: rgen()}
+	var algo = kmeans.NewAlgo(implConf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+}
+
+func Test_ParMiniBatchRunSyncPP(t *testing.T) {
+	var implConf = kmeans.Conf{K: 3, MiniBatch: 4, Par: true, CtrlConf: core.CtrlConf{Iter: 50}, RGen: rgen()}
+	var algo = kmeans.NewAlgo(implConf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+}
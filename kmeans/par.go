@@ -7,8 +7,14 @@ import (
 // NewParImpl parallelizes algorithm implementation
 func NewParImpl(conf Conf, initializer core.Initializer, data []core.Elemt, args ...interface{}) (impl Impl) {
 	impl = NewSeqImpl(conf, initializer, data)
-	impl.strategy = ParStrategy{
-		Degree: conf.NumCPU,
+	if conf.MiniBatch > 0 {
+		conf.Par = true
+		impl.strategy = NewMiniBatchStrategy(conf)
+	} else {
+		impl.strategy = &ParStrategy{
+			Degree: conf.NumCPU,
+			Bounds: impl.bounds,
+		}
 	}
 	return
 }
@@ -16,10 +22,23 @@ func NewParImpl(conf Conf, initializer core.Initializer, data []core.Elemt, args
 // ParStrategy parallelizes algorithm strategy
 type ParStrategy struct {
 	Degree int
+	Bounds *core.Bounds // bounds used to accelerate assignments if not nil
+	stats  []core.ClusterStats
 }
 
 // Iterate processes input cluster
-func (strategy ParStrategy) Iterate(space core.Space, centroids core.Clust, data []core.Elemt) core.Clust {
-	result, _ := centroids.ParReduceDBA(data, space, strategy.Degree)
-	return result
+func (strategy *ParStrategy) Iterate(space core.Space, centroids core.Clust, data []core.Elemt, weights []int) (result core.Clust) {
+	if median, ok := space.(MedianSpace); ok {
+		result, strategy.stats = reduceMedians(centroids, data, weights, strategy.Bounds, median, strategy.Degree)
+	} else if strategy.Bounds != nil {
+		result, strategy.stats = centroids.ParReduceDBAWithBounds(data, weights, strategy.Bounds, space, norm, strategy.Degree)
+	} else {
+		result, strategy.stats = centroids.ParReduceDBAWithStats(data, weights, space, norm, strategy.Degree)
+	}
+	return
+}
+
+// Stats returns cluster statistics of the last iteration, distances being computed before centroids update
+func (strategy *ParStrategy) Stats() []core.ClusterStats {
+	return strategy.stats
 }
@@ -34,3 +34,11 @@ func Test_ParRunAsync(t *testing.T) {
 	test.DoTestRunAsyncCentroids(t, algo)
 	test.DoTestRunAsyncPush(t, algo)
 }
+
+func Test_ParAccelerateRunSyncPP(t *testing.T) {
+	kmeansConf := kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 20}, RGen: rgen(), Par: true, Accelerate: true}
+	var algo = kmeans.NewAlgo(kmeansConf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
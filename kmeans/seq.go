@@ -5,24 +5,48 @@ import (
 )
 
 // NewSeqImpl returns a sequential algorithm execution
-func NewSeqImpl(conf Conf, initializer core.Initializer, data []core.Elemt, args ...interface{}) Impl {
-	return Impl{
+func NewSeqImpl(conf Conf, initializer core.Initializer, data []core.Elemt, args ...interface{}) (impl Impl) {
+	impl = Impl{
 		buffer:      core.NewDataBuffer(data, conf.FrameSize),
 		strategy:    &SeqStrategy{},
 		initializer: initializer,
+		frameSize:   conf.FrameSize,
 	}
+	if conf.Accelerate {
+		impl.bounds = &core.Bounds{}
+		impl.strategy = &SeqStrategy{Bounds: impl.bounds}
+	}
+	if conf.MiniBatch > 0 {
+		conf.Par = false
+		impl.strategy = NewMiniBatchStrategy(conf)
+	}
+	return
 }
 
 // SeqStrategy defines strategy for sequential execution
 type SeqStrategy struct {
+	Bounds *core.Bounds // bounds used to accelerate assignments if not nil
+	stats  []core.ClusterStats
 }
 
 // Iterate processes input cluster
-func (strategy *SeqStrategy) Iterate(space core.Space, centroids core.Clust, data []core.Elemt) core.Clust {
-	var result, _ = centroids.ReduceDBA(data, space)
+func (strategy *SeqStrategy) Iterate(space core.Space, centroids core.Clust, data []core.Elemt, weights []int) core.Clust {
+	var result core.Clust
+	if median, ok := space.(MedianSpace); ok {
+		result, strategy.stats = reduceMedians(centroids, data, weights, strategy.Bounds, median, 1)
+	} else if strategy.Bounds != nil {
+		result, strategy.stats = centroids.ReduceDBAWithBounds(data, weights, strategy.Bounds, space, norm)
+	} else {
+		result, strategy.stats = centroids.ReduceDBAWithStats(data, weights, space, norm)
+	}
 	return strategy.buildResult(centroids, result)
 }
 
+// Stats returns cluster statistics of the last iteration, distances being computed before centroids update
+func (strategy *SeqStrategy) Stats() []core.ClusterStats {
+	return strategy.stats
+}
+
 func (strategy SeqStrategy) buildResult(centroids core.Clust, result core.Clust) core.Clust {
 	for i := 0; i < len(result); i++ {
 		if result[i] == nil {
@@ -67,3 +67,37 @@ func Test_Empty(t *testing.T) {
 
 	test.DoTestEmpty(t, builder)
 }
+
+func Test_AccelerateRunSyncPP(t *testing.T) {
+	var implConf = kmeans.Conf{K: 3, Accelerate: true, CtrlConf: core.CtrlConf{Iter: 20}, RGen: rgen()}
+	var algo = kmeans.NewAlgo(implConf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
+
+func Test_AccelerateCentroids(t *testing.T) {
+	var data []core.Elemt
+	for i := 0; i < 10; i++ {
+		data = append(data, test.Vectors...)
+	}
+	for _, par := range []bool{false, true} {
+		var conf = kmeans.Conf{K: 3, Par: par, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+		var algo = kmeans.NewAlgo(conf, space, data, kmeans.PPInitializer)
+		test.AssertNoError(t, algo.Batch())
+
+		conf.Accelerate, conf.RGen = true, rgen()
+		var accelerated = kmeans.NewAlgo(conf, space, data, kmeans.PPInitializer)
+		test.AssertNoError(t, accelerated.Batch())
+
+		test.AssertCentroids(t, algo.Centroids(), accelerated.Centroids())
+	}
+}
+
+func Test_AccelerateRunAsyncFrame(t *testing.T) {
+	var implConf = kmeans.Conf{K: 3, Accelerate: true, FrameSize: 8, CtrlConf: core.CtrlConf{Iter: 1000}, RGen: rgen()}
+	var algo = kmeans.NewAlgo(implConf, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestRunAsync(t, algo)
+	test.DoTestRunAsyncPush(t, algo)
+}
@@ -0,0 +1,12 @@
+// Package kmedoids provides k-medoids (PAM/CLARA) based implementation of online clustering.
+// Centers are buffered elements and only the distance of the space is used.
+package kmedoids
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new kmedoids algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, initializer core.Initializer, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, initializer, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
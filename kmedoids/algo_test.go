@@ -0,0 +1,61 @@
+package kmedoids_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/kmedoids"
+
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+func rgen() *rand.Rand {
+	return rand.New(rand.NewSource(6305689164243))
+}
+
+func newAlgo(t *testing.T, conf core.CtrlConf, size int) (algo *core.Algo) {
+	var implConf = kmedoids.Conf{K: 3, CtrlConf: conf}
+	var clust = make(core.Clust, size)
+	for i := range clust {
+		clust[i] = []float64{0, 1, 2}
+	}
+	return kmedoids.NewAlgo(implConf, space, clust, kmeans.GivenInitializer)
+}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var algo = newAlgo(t, core.CtrlConf{Iter: 1}, 10)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_Initialization(t *testing.T) {
+	var algo = kmedoids.NewAlgo(kmedoids.Conf{K: 3}, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestInitialization(t, algo)
+}
+
+func Test_RunSyncGiven(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = kmedoids.NewAlgo(conf, space, []core.Elemt{}, kmeans.GivenInitializer)
+
+	test.DoTestRunSyncGiven(t, algo)
+}
+
+func Test_RunSyncPP(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 20}, RGen: rgen()}
+	var algo = kmedoids.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestRunSyncPP(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000}, RGen: rgen()}
+	var algo = kmedoids.NewAlgo(conf, space, []core.Elemt{}, kmeans.PPInitializer)
+
+	test.DoTestWorkflow(t, algo)
+}
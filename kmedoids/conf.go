@@ -0,0 +1,47 @@
+package kmedoids
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of KMedoids
+type Conf struct {
+	core.CtrlConf
+	K         int
+	FrameSize int
+	RGen      *rand.Rand
+	PAMSize   int // maximal number of buffered elements clustered with PAM, CLARA samples of this size are used beyond. 40+2K if 0
+	Samples   int // number of CLARA samples drawn at each iteration, 5 if 0
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	if conf.K < 1 {
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	} else if conf.PAMSize < conf.K {
+		err = fmt.Errorf("Illegal value for PAMSize: %v", conf.PAMSize)
+	} else if conf.Samples < 1 {
+		err = fmt.Errorf("Illegal value for Samples: %v", conf.Samples)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.PAMSize == 0 {
+		conf.PAMSize = 40 + 2*conf.K
+	}
+	if conf.Samples == 0 {
+		conf.Samples = 5
+	}
+}
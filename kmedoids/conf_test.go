@@ -0,0 +1,40 @@
+package kmedoids_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmedoids"
+)
+
+func TestKMedoids_ConfErrorK(t *testing.T) {
+	var conf = kmedoids.Conf{K: -12, CtrlConf: core.CtrlConf{Iter: 10}}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestKMedoids_ConfDefault(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3}
+	var err = conf.Verify()
+	if err != nil || conf.PAMSize != 46 || conf.Samples != 5 || conf.RGen == nil {
+		t.Error("default values expected", err, conf)
+	}
+}
+
+func TestKMedoids_ConfErrorPAMSize(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, PAMSize: 2}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestKMedoids_ConfErrorSamples(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, Samples: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
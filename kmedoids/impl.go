@@ -0,0 +1,113 @@
+package kmedoids
+
+import (
+	"io"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Impl algorithm implementation
+type Impl struct {
+	buffer      core.Buffer
+	initializer core.Initializer
+	stats       []core.ClusterStats
+}
+
+// NewImpl creates a new kmedoids implementation
+func NewImpl(conf Conf, initializer core.Initializer, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer:      core.NewDataBuffer(data, conf.FrameSize),
+		initializer: initializer,
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 1.
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	var conf = model.Conf().(*Conf)
+	_ = impl.buffer.Apply()
+	return impl.initializer(conf.K, impl.buffer.Data(), model.Space(), conf.RGen)
+}
+
+// Iterate runs a PAM swap if buffered elements are less than PAMSize and CLARA sampling otherwise
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+	if len(data) <= conf.PAMSize {
+		clust, _ = Swap(model.Centroids(), data, weights, model.Space())
+	} else {
+		clust = impl.clara(conf, model.Centroids(), data, weights, model.Space())
+	}
+	impl.stats = clust.ReduceStats(data, weights, model.Space(), norm)
+	return clust, nil, impl.buffer.Apply()
+}
+
+// clara runs PAM on samples of the buffered elements (including current medoids)
+// and returns the medoids with the lowest cost over all buffered elements
+func (impl *Impl) clara(conf *Conf, medoids core.Clust, data []core.Elemt, weights []int, space core.Space) core.Clust {
+	var best = medoids
+	var bestCost = Cost(medoids, data, weights, space)
+	for s := 0; s < conf.Samples; s++ {
+		var sample = make([]core.Elemt, 0, conf.PAMSize+len(medoids))
+		var sampleWeights []int
+		if weights != nil {
+			sampleWeights = make([]int, 0, cap(sample))
+		}
+		for _, i := range conf.RGen.Perm(len(data))[:conf.PAMSize] {
+			sample = append(sample, data[i])
+			if weights != nil {
+				sampleWeights = append(sampleWeights, weights[i])
+			}
+		}
+		for _, medoid := range best {
+			sample = append(sample, medoid)
+			if weights != nil {
+				sampleWeights = append(sampleWeights, 1)
+			}
+		}
+		var candidate = PAM(best, sample, sampleWeights, space, conf.PAMSize)
+		if cost := Cost(candidate, data, weights, space); cost < bestCost {
+			best, bestCost = candidate, cost
+		}
+	}
+	return best
+}
+
+// Stats returns cluster statistics of the last iteration, losses being sums of distances to medoids
+func (impl *Impl) Stats() []core.ClusterStats {
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
+	return impl.buffer.Push(elemt, model.Status().Alive())
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) error {
+	return impl.buffer.PushWeighted(elemt, weight, model.Status().Alive())
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, impl.initializer, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	return impl.buffer.Load(r)
+}
@@ -0,0 +1,100 @@
+package kmedoids_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/kmedoids"
+	"github.com/wearelumenai/distclus/levenshtein"
+)
+
+func TestImpl_CLARA(t *testing.T) {
+	var data []core.Elemt
+	for i := 0; i < 50; i++ {
+		data = append(data, test.Vectors...)
+	}
+	var conf = kmedoids.Conf{K: 3, PAMSize: 10, Samples: 3, CtrlConf: core.CtrlConf{Iter: 5}, RGen: rgen()}
+	var algo = kmedoids.NewAlgo(conf, space, data, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var medoids = algo.Centroids()
+	var labels, _ = medoids.MapLabel(test.Vectors, space)
+	var expected = []int{labels[0], labels[1], labels[2], labels[0], labels[0], labels[1], labels[2], labels[2]}
+	test.AssertArrayEqual(t, expected, labels)
+}
+
+func TestImpl_Strings(t *testing.T) {
+	var data = []core.Elemt{"connection refused", "connection reset", "disk full", "disk fail", "connection refused!", "disk is full"}
+	var conf = kmedoids.Conf{K: 2, CtrlConf: core.CtrlConf{Iter: 5}, RGen: rgen()}
+	var lspace = levenshtein.NewSpace(levenshtein.Conf{})
+	var algo = kmedoids.NewAlgo(conf, lspace, data, kmeans.GivenInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var medoids = algo.Centroids()
+	var labels, _ = medoids.MapLabel(data, lspace)
+	test.AssertArrayEqual(t, []int{labels[0], labels[0], labels[2], labels[2], labels[0], labels[2]}, labels)
+	if labels[0] == labels[2] {
+		t.Error("Expected 2 clusters got", labels)
+	}
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+	var centroids = core.Clust(test.Vectors[:3])
+	var initializer = centroids.Initializer
+	var weighted = kmedoids.NewAlgo(conf, space, nil, initializer)
+	var duplicated = kmedoids.NewAlgo(conf, space, nil, initializer)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	test.AssertCentroids(t, duplicated.Centroids(), weighted.Centroids())
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	test.AssertCentroids(t, weighted.Centroids(), copied.Centroids())
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+	var algo = kmedoids.NewAlgo(conf, space, test.Vectors, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var stats = algo.Stats()
+	var size, loss = 0, 0.
+	for i := range stats {
+		size += stats[i].Size
+		loss += stats[i].Loss
+	}
+	if len(stats) != 3 || size != len(test.Vectors) {
+		t.Error("Expected 3 clusters of", len(test.Vectors), "elements got", stats)
+	}
+	test.AssertAlmostEqual(t, kmedoids.Cost(algo.Centroids(), test.Vectors, nil, space), loss)
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = kmedoids.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rgen()}
+	var algo = kmedoids.NewAlgo(conf, space, test.Vectors, kmeans.GivenInitializer)
+	_ = algo.Init()
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+
+	var impl = kmedoids.NewImpl(conf, kmeans.GivenInitializer, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	test.AssertNoError(t, algo.Batch())
+	test.AssertNoError(t, restored.Batch())
+	test.AssertCentroids(t, algo.Centroids(), restored.Centroids())
+}
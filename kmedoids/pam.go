@@ -0,0 +1,89 @@
+package kmedoids
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Cost returns the weighted sum of distances between elements and their nearest medoid
+func Cost(medoids core.Clust, data []core.Elemt, weights []int, space core.Space) (cost float64) {
+	var _, dists = medoids.MapLabel(data, space)
+	for i := range dists {
+		cost += float64(core.Weight(weights, i)) * dists[i]
+	}
+	return
+}
+
+// Swap performs the PAM swap of a medoid with an element that decreases the most the cost.
+// Medoids are returned unchanged if no swap decreases the cost.
+func Swap(medoids core.Clust, data []core.Elemt, weights []int, space core.Space) (result core.Clust, improved bool) {
+	var nearest, second, labels = nearests(medoids, data, space)
+
+	var bestDelta, bestMedoid, bestElemt = 0., -1, -1
+	var dists = make([]float64, len(data))
+	var deltas = make([]float64, len(medoids))
+	for o := range data {
+		if nearest[o] == 0 {
+			continue // already a medoid
+		}
+		for i := range data {
+			dists[i] = space.Dist(data[i], data[o])
+		}
+		for m := range deltas {
+			deltas[m] = 0
+		}
+		var shared = 0.
+		for i := range data {
+			var w = float64(core.Weight(weights, i))
+			if dists[i] < nearest[i] {
+				// the element moves to o whichever medoid is removed
+				shared += w * (dists[i] - nearest[i])
+			} else {
+				// the element moves to o or its second nearest medoid if its medoid is removed
+				deltas[labels[i]] += w * (math.Min(dists[i], second[i]) - nearest[i])
+			}
+		}
+		for m := range deltas {
+			if delta := shared + deltas[m]; delta < bestDelta-1e-12 {
+				bestDelta, bestMedoid, bestElemt = delta, m, o
+			}
+		}
+	}
+
+	result = make(core.Clust, len(medoids))
+	copy(result, medoids)
+	if bestMedoid >= 0 {
+		result[bestMedoid] = data[bestElemt]
+		improved = true
+	}
+	return
+}
+
+// PAM performs swaps until the cost does not decrease or the maximal number of swaps is reached
+func PAM(medoids core.Clust, data []core.Elemt, weights []int, space core.Space, maxSwaps int) core.Clust {
+	var improved = true
+	for i := 0; i < maxSwaps && improved; i++ {
+		medoids, improved = Swap(medoids, data, weights, space)
+	}
+	return medoids
+}
+
+// nearests returns distances of elements to their nearest and second nearest medoids and nearest medoid labels
+func nearests(medoids core.Clust, data []core.Elemt, space core.Space) (nearest []float64, second []float64, labels []int) {
+	nearest = make([]float64, len(data))
+	second = make([]float64, len(data))
+	labels = make([]int, len(data))
+	for i := range data {
+		nearest[i], second[i] = math.Inf(1), math.Inf(1)
+		for m := range medoids {
+			var d = space.Dist(data[i], medoids[m])
+			if d < nearest[i] {
+				second[i], nearest[i], labels[i] = nearest[i], d, m
+			} else if d < second[i] {
+				second[i] = d
+			}
+		}
+	}
+	return
+}
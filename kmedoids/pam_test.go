@@ -0,0 +1,50 @@
+package kmedoids_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmedoids"
+)
+
+func bestSwapCost(medoids core.Clust, data []core.Elemt, weights []int) (best float64) {
+	best = kmedoids.Cost(medoids, data, weights, space)
+	for m := range medoids {
+		for o := range data {
+			var swapped = make(core.Clust, len(medoids))
+			copy(swapped, medoids)
+			swapped[m] = data[o]
+			if cost := kmedoids.Cost(swapped, data, weights, space); cost < best {
+				best = cost
+			}
+		}
+	}
+	return
+}
+
+func TestSwap(t *testing.T) {
+	var weights = []int{1, 2, 3, 1, 2, 3, 1, 2}
+	for _, w := range [][]int{nil, weights} {
+		var medoids = core.Clust{test.Vectors[0], test.Vectors[3], test.Vectors[4]}
+		for improved := true; improved; {
+			var expected = bestSwapCost(medoids, test.Vectors, w)
+			medoids, improved = kmedoids.Swap(medoids, test.Vectors, w, space)
+			test.AssertAlmostEqual(t, expected, kmedoids.Cost(medoids, test.Vectors, w, space))
+		}
+	}
+}
+
+func TestPAM(t *testing.T) {
+	var medoids = kmedoids.PAM(core.Clust{test.Vectors[0], test.Vectors[3], test.Vectors[4]}, test.Vectors, nil, space, 10)
+	var labels, _ = medoids.MapLabel(test.Vectors, space)
+	var expected = []int{labels[0], labels[1], labels[2], labels[0], labels[0], labels[1], labels[2], labels[2]}
+	test.AssertArrayEqual(t, expected, labels)
+	for _, medoid := range medoids {
+		var found = false
+		for _, elemt := range test.Vectors {
+			found = found || space.Dist(medoid, elemt) == 0
+		}
+		test.AssertTrue(t, found)
+	}
+}
@@ -0,0 +1,174 @@
+// Package levenshtein allows to computes clusters of strings with edit distance.
+package levenshtein
+
+import (
+	"encoding/gob"
+)
+
+func init() {
+	gob.Register(Medoid{})
+}
+
+// Dist returns the Levenshtein distance between two strings, that is the minimal number of
+// rune insertions, deletions and substitutions that transform one into the other
+func Dist(s1, s2 string) int {
+	var r1, r2 = []rune(s1), []rune(s2)
+	if len(r1) < len(r2) {
+		r1, r2 = r2, r1
+	}
+	var previous = make([]int, len(r2)+1)
+	var current = make([]int, len(r2)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(r1); i++ {
+		current[0] = i
+		for j := 1; j <= len(r2); j++ {
+			var cost = 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+			current[j] = minOf(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(r2)]
+}
+
+func minOf(values ...int) int {
+	var m = values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Medoid is the center of strings.
+// It keeps a bounded set of weighted candidate strings, the medoid value being the candidate
+// that minimizes the weighted sum of distances to the others.
+type Medoid struct {
+	Value      string
+	Candidates []string
+	Weights    []float64
+}
+
+// toMedoid returns the medoid of a string or medoid scaled to the given weight
+func toMedoid(elemt interface{}, weight int) Medoid {
+	switch value := elemt.(type) {
+	case Medoid:
+		return value.scale(float64(weight))
+	default:
+		var s = value.(string)
+		return Medoid{Value: s, Candidates: []string{s}, Weights: []float64{float64(weight)}}
+	}
+}
+
+// value returns a string or the value of a medoid
+func value(elemt interface{}) string {
+	switch v := elemt.(type) {
+	case Medoid:
+		return v.Value
+	default:
+		return v.(string)
+	}
+}
+
+// scale returns a medoid whose weights sum to weight
+func (medoid Medoid) scale(weight float64) Medoid {
+	var scaled = medoid.Copy()
+	var total = 0.
+	for _, w := range medoid.Weights {
+		total += w
+	}
+	for i := range scaled.Weights {
+		if total > 0 {
+			scaled.Weights[i] = scaled.Weights[i] * weight / total
+		}
+	}
+	return scaled
+}
+
+// Copy returns a deep copy of the medoid
+func (medoid Medoid) Copy() Medoid {
+	var copied = Medoid{
+		Value:      medoid.Value,
+		Candidates: make([]string, len(medoid.Candidates)),
+		Weights:    make([]float64, len(medoid.Weights)),
+	}
+	copy(copied.Candidates, medoid.Candidates)
+	copy(copied.Weights, medoid.Weights)
+	return copied
+}
+
+// CombineMedoids merges the candidates of two strings or medoids.
+// If there are more than size candidates, the least central ones are dropped.
+func CombineMedoids(elemt1 interface{}, weight1 int, elemt2 interface{}, weight2 int, size int) Medoid {
+	var medoid = toMedoid(elemt1, weight1)
+	var other = toMedoid(elemt2, weight2)
+	for i, candidate := range other.Candidates {
+		medoid.add(candidate, other.Weights[i])
+	}
+
+	for len(medoid.Candidates) > size {
+		var costs = medoid.costs()
+		var worst = 0
+		for i := range costs {
+			if costs[i] > costs[worst] {
+				worst = i
+			}
+		}
+		medoid.remove(worst)
+	}
+
+	var costs = medoid.costs()
+	var best = 0
+	for i := range costs {
+		if costs[i] < costs[best] || (costs[i] == costs[best] && medoid.Candidates[i] < medoid.Candidates[best]) {
+			best = i
+		}
+	}
+	medoid.Value = medoid.Candidates[best]
+	return medoid
+}
+
+// add a weighted candidate, weights being summed if the candidate is already known
+func (medoid *Medoid) add(candidate string, weight float64) {
+	for i := range medoid.Candidates {
+		if medoid.Candidates[i] == candidate {
+			medoid.Weights[i] += weight
+			return
+		}
+	}
+	medoid.Candidates = append(medoid.Candidates, candidate)
+	medoid.Weights = append(medoid.Weights, weight)
+}
+
+// remove the candidate at the given index, its weight is given to the nearest remaining candidate
+func (medoid *Medoid) remove(index int) {
+	var weight = medoid.Weights[index]
+	var candidate = medoid.Candidates[index]
+	medoid.Candidates = append(medoid.Candidates[:index], medoid.Candidates[index+1:]...)
+	medoid.Weights = append(medoid.Weights[:index], medoid.Weights[index+1:]...)
+	var nearest, closest = 0, -1
+	for i := range medoid.Candidates {
+		if d := Dist(candidate, medoid.Candidates[i]); closest < 0 || d < closest {
+			nearest, closest = i, d
+		}
+	}
+	medoid.Weights[nearest] += weight
+}
+
+// costs returns for each candidate the weighted sum of its distances to all candidates
+func (medoid Medoid) costs() []float64 {
+	var costs = make([]float64, len(medoid.Candidates))
+	for i := range medoid.Candidates {
+		for j := i + 1; j < len(medoid.Candidates); j++ {
+			var d = float64(Dist(medoid.Candidates[i], medoid.Candidates[j]))
+			costs[i] += medoid.Weights[j] * d
+			costs[j] += medoid.Weights[i] * d
+		}
+	}
+	return costs
+}
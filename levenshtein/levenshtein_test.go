@@ -0,0 +1,132 @@
+package levenshtein_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/levenshtein"
+	"github.com/wearelumenai/distclus/streaming"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDist(t *testing.T) {
+	var cases = []struct {
+		s1, s2 string
+		dist   int
+	}{
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"flaw", "lawn", 2},
+		{"héllo", "hello", 1},
+		{"same", "same", 0},
+	}
+	for _, c := range cases {
+		if d := levenshtein.Dist(c.s1, c.s2); d != c.dist {
+			t.Error("Expected", c.dist, "got", d, c.s1, c.s2)
+		}
+	}
+}
+
+func TestSpace_Combine(t *testing.T) {
+	var space = levenshtein.NewSpace(levenshtein.Conf{})
+	var medoid = space.Combine("connection refused", 1, "connection refused", 1)
+	medoid = space.Combine(medoid, 2, "connection reset", 1)
+	medoid = space.Combine(medoid, 3, "connection refused by peer", 1)
+
+	var m = medoid.(levenshtein.Medoid)
+	if m.Value != "connection refused" || len(m.Candidates) != 3 {
+		t.Error("Expected connection refused medoid got", m)
+	}
+	test.AssertAlmostEqual(t, 0., space.Dist(m, "connection refused"))
+	test.AssertAlmostEqual(t, 2., m.Weights[0])
+}
+
+func TestSpace_Candidates(t *testing.T) {
+	var space = levenshtein.NewSpace(levenshtein.Conf{Candidates: 3})
+	var medoid core.Elemt = "aaaa"
+	for i, s := range []string{"aaab", "aaba", "abaa", "baaa", "zzzz"} {
+		medoid = space.Combine(medoid, i+1, s, 1)
+		if n := len(medoid.(levenshtein.Medoid).Candidates); n > 3 {
+			t.Error("Expected at most 3 candidates got", n)
+		}
+	}
+	var m = medoid.(levenshtein.Medoid)
+	for _, candidate := range m.Candidates {
+		if candidate == "zzzz" {
+			t.Error("Expected outlier candidate to be dropped", m)
+		}
+	}
+	var total = 0.
+	for _, w := range m.Weights {
+		total += w
+	}
+	test.AssertAlmostEqual(t, 6., total)
+}
+
+func TestSpace_Copy(t *testing.T) {
+	var space = levenshtein.NewSpace(levenshtein.Conf{})
+	var medoid = space.Combine("ab", 1, "abc", 1).(levenshtein.Medoid)
+	var copied = space.Copy(medoid).(levenshtein.Medoid)
+	copied.Weights[0] = 10
+	test.AssertAlmostEqual(t, 1., medoid.Weights[0])
+	test.AssertEqual(t, 3, space.Dim([]core.Elemt{"ab", medoid, "héé"}))
+	test.AssertTrue(t, core.IsMetric(space))
+}
+
+func templates() (data []core.Elemt) {
+	var prefixes = []string{"user logged in from host", "disk usage above threshold on", "request timeout after"}
+	for i := 0; i < 30; i++ {
+		data = append(data, prefixes[i%3]+" "+string(rune('a'+i%5)))
+	}
+	return
+}
+
+func assertTemplates(t *testing.T, algo *core.Algo, data []core.Elemt) {
+	var _, first, _ = algo.Predict(data[0])
+	for i := range data {
+		var _, label, _ = algo.Predict(data[i])
+		if (i%3 == 0) != (label == first) {
+			t.Error("Expected strings of the same template in the same cluster")
+		}
+	}
+}
+
+func TestSpace_KMeans(t *testing.T) {
+	var data = templates()
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 5}, RGen: rand.New(rand.NewSource(6305689164243))}
+	var algo = kmeans.NewAlgo(conf, levenshtein.NewSpace(levenshtein.Conf{Candidates: 4}), data, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+	assertTemplates(t, algo, data)
+}
+
+func TestSpace_Streaming(t *testing.T) {
+	var data = templates()
+	var conf = streaming.Conf{BufferSize: 30, CtrlConf: core.CtrlConf{Iter: 25}, RGen: rand.New(rand.NewSource(6305689164243))}
+	var algo = streaming.NewAlgo(conf, levenshtein.NewSpace(levenshtein.Conf{}), data)
+	test.AssertNoError(t, algo.Batch())
+	assertTemplates(t, algo, data)
+}
+
+func TestCodec(t *testing.T) {
+	var space = levenshtein.NewSpace(levenshtein.Conf{})
+	var medoid = space.Combine("héllo", 2, "hello", 1)
+	for _, format := range []core.Format{core.Binary, core.JSON} {
+		var codec, err = core.GetCodec(space, format)
+		test.AssertNoError(t, err)
+		for _, elemt := range []core.Elemt{"héllo", medoid} {
+			var data, _ = codec.Encode(elemt)
+			var decoded, err = codec.Decode(data)
+			test.AssertNoError(t, err)
+			if decoded != "héllo" {
+				t.Error("Expected héllo got", decoded)
+			}
+		}
+	}
+	if _, err := space.Codec(core.Format(-1)); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+}
@@ -0,0 +1,106 @@
+package levenshtein
+
+import (
+	"encoding/json"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Conf defines the number of candidates kept by medoids
+type Conf struct {
+	Candidates int // maximal number of candidate strings of a medoid, 16 if not positive
+}
+
+// Space for strings with Levenshtein distance.
+// Combining strings results in an approximate medoid (Medoid) chosen among a bounded set of candidates.
+type Space struct {
+	candidates int
+}
+
+// NewSpace creates a new Space
+func NewSpace(conf Conf) Space {
+	var candidates = conf.Candidates
+	if candidates <= 0 {
+		candidates = 16
+	}
+	return Space{
+		candidates: candidates,
+	}
+}
+
+// Dist returns the Levenshtein distance between strings or medoids
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return float64(Dist(value(elemt1), value(elemt2)))
+}
+
+// IsMetric returns true since the Levenshtein distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
+// Combine returns the approximate medoid of strings or medoids
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return CombineMedoids(elemt1, weight1, elemt2, weight2, space.candidates)
+}
+
+// Copy creates a copy of a string or medoid
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	if medoid, ok := elemt.(Medoid); ok {
+		return medoid.Copy()
+	}
+	return elemt
+}
+
+// Dim returns the greatest number of runes of the given strings or medoids
+func (space Space) Dim(data []core.Elemt) (dim int) {
+	for _, elemt := range data {
+		if n := len([]rune(value(elemt))); n > dim {
+			dim = n
+		}
+	}
+	return
+}
+
+// Codec returns the string codec for the given format, medoids being encoded as their value
+func (space Space) Codec(format core.Format) (codec core.Codec, err error) {
+	switch format {
+	case core.Binary:
+		codec = BinaryCodec{}
+	case core.JSON:
+		codec = JSONCodec{}
+	default:
+		err = core.ErrFormat
+	}
+	return
+}
+
+// BinaryCodec encodes strings as their UTF-8 bytes
+type BinaryCodec struct{}
+
+// Encode returns the bytes of a string or medoid value
+func (BinaryCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	return []byte(value(elemt)), nil
+}
+
+// Decode returns the string of data
+func (BinaryCodec) Decode(data []byte) (core.Elemt, error) {
+	return string(data), nil
+}
+
+// JSONCodec encodes strings as JSON strings
+type JSONCodec struct{}
+
+// Encode returns the JSON encoding of a string or medoid value
+func (JSONCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	return json.Marshal(value(elemt))
+}
+
+// Decode returns the string encoded in data
+func (JSONCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var s string
+	err = json.Unmarshal(data, &s)
+	if err == nil {
+		elemt = s
+	}
+	return
+}
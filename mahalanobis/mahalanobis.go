@@ -0,0 +1,154 @@
+// Package mahalanobis allows to computes Mahalanobis distance based clusters.
+package mahalanobis
+
+import (
+	"errors"
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// ErrCovariance raised when the covariance matrix is not symmetric positive definite
+var ErrCovariance = errors.New("covariance matrix must be symmetric positive definite")
+
+// Conf defines the covariance of the Mahalanobis distance
+type Conf struct {
+	Covariance [][]float64 // covariance matrix, see Estimate for a covariance estimated from data
+}
+
+// Space for vectors ([]float64) with Mahalanobis distance
+type Space struct {
+	precision [][]float64 // inverse of the covariance matrix
+	vspace    euclid.Space
+}
+
+// NewSpace creates a new Space given a symmetric positive definite covariance matrix
+func NewSpace(conf Conf) (space Space, err error) {
+	var dim = len(conf.Covariance)
+	if dim == 0 {
+		err = ErrCovariance
+		return
+	}
+	var cov = mat.NewSymDense(dim, nil)
+	for i := range conf.Covariance {
+		if len(conf.Covariance[i]) != dim {
+			err = ErrCovariance
+			return
+		}
+		for j := 0; j <= i; j++ {
+			if conf.Covariance[i][j] != conf.Covariance[j][i] {
+				err = ErrCovariance
+				return
+			}
+			cov.SetSym(i, j, conf.Covariance[i][j])
+		}
+	}
+
+	var chol mat.Cholesky
+	if !chol.Factorize(cov) {
+		err = ErrCovariance
+		return
+	}
+	var inv mat.SymDense
+	if err = chol.InverseTo(&inv); err != nil {
+		return
+	}
+	space.precision = make([][]float64, dim)
+	for i := range space.precision {
+		space.precision[i] = make([]float64, dim)
+		for j := range space.precision[i] {
+			space.precision[i][j] = inv.At(i, j)
+		}
+	}
+	return
+}
+
+// Estimate returns the covariance matrix of weighted vectors. Nil weights stand for unit weights.
+func Estimate(data []core.Elemt, weights []int) [][]float64 {
+	if len(data) == 0 {
+		return nil
+	}
+	var dim = len(data[0].([]float64))
+	var x = mat.NewDense(len(data), dim, nil)
+	var w []float64
+	if weights != nil {
+		w = make([]float64, len(weights))
+	}
+	for i := range data {
+		x.SetRow(i, data[i].([]float64))
+		if w != nil {
+			w[i] = float64(weights[i])
+		}
+	}
+	var cov mat.SymDense
+	stat.CovarianceMatrix(&cov, x, w)
+
+	var result = make([][]float64, dim)
+	for i := range result {
+		result[i] = make([]float64, dim)
+		for j := range result[i] {
+			result[i][j] = cov.At(i, j)
+		}
+	}
+	return result
+}
+
+// Dist computes the Mahalanobis distance between two vectors
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return space.PointDist(elemt1.([]float64), elemt2.([]float64))
+}
+
+// IsMetric returns true since the Mahalanobis distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
+// PointDist returns the Mahalanobis distance between two points
+func (space Space) PointDist(point1 []float64, point2 []float64) float64 {
+	var diff = make([]float64, len(point1))
+	for i := range point1 {
+		diff[i] = point1[i] - point2[i]
+	}
+	var sum = 0.
+	for i := range diff {
+		var row = 0.
+		for j := range diff {
+			row += space.precision[i][j] * diff[j]
+		}
+		sum += diff[i] * row
+	}
+	return math.Sqrt(math.Max(sum, 0))
+}
+
+// Combine returns the weighted average of elemt1 and elemt2
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return space.vspace.Combine(elemt1, weight1, elemt2, weight2)
+}
+
+// PointCombine returns the weighted average of points
+func (space Space) PointCombine(point1 []float64, weight1 int, point2 []float64, weight2 int) []float64 {
+	return space.vspace.PointCombine(point1, weight1, point2, weight2)
+}
+
+// Copy creates a copy of a vector
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	return space.vspace.Copy(elemt)
+}
+
+// PointCopy copy points
+func (space Space) PointCopy(point []float64) []float64 {
+	return space.vspace.PointCopy(point)
+}
+
+// Dim returns input data dimension
+func (space Space) Dim(data []core.Elemt) int {
+	return space.vspace.Dim(data)
+}
+
+// Codec returns the vector codec for the given format
+func (space Space) Codec(format core.Format) (core.Codec, error) {
+	return space.vspace.Codec(format)
+}
@@ -0,0 +1,55 @@
+package mahalanobis_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dtw"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/mahalanobis"
+)
+
+var _ dtw.PointSpace = mahalanobis.Space{}
+
+func TestSpace_Dist(t *testing.T) {
+	var space, err = mahalanobis.NewSpace(mahalanobis.Conf{Covariance: [][]float64{{4., 0.}, {0., 1.}}})
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, math.Sqrt(2.), space.Dist([]float64{0., 0.}, []float64{2., 1.}))
+	test.AssertTrue(t, core.IsMetric(space))
+}
+
+func TestSpace_Identity(t *testing.T) {
+	var space, err = mahalanobis.NewSpace(mahalanobis.Conf{Covariance: [][]float64{{1., 0.}, {0., 1.}}})
+	test.AssertNoError(t, err)
+	var v1, v2 = []float64{1., 2.}, []float64{-3., 5.}
+	test.AssertAlmostEqual(t, euclid.NewSpace().Dist(v1, v2), space.Dist(v1, v2))
+}
+
+func TestNewSpace_Error(t *testing.T) {
+	var covariances = [][][]float64{
+		nil,
+		{{1., 2.}, {2., 1.}},
+		{{1., 0.}, {.5, 1.}},
+		{{1., 0.}},
+	}
+	for _, cov := range covariances {
+		if _, err := mahalanobis.NewSpace(mahalanobis.Conf{Covariance: cov}); err != mahalanobis.ErrCovariance {
+			t.Error("Expected covariance error got", err)
+		}
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	var data = []core.Elemt{[]float64{0., 0.}, []float64{2., 2.}, []float64{0., 2.}, []float64{2., 0.}}
+	var cov = mahalanobis.Estimate(data, nil)
+	test.AssertArrayAlmostEqual(t, []float64{4. / 3, 0.}, cov[0])
+	test.AssertArrayAlmostEqual(t, []float64{0., 4. / 3}, cov[1])
+
+	var _, err = mahalanobis.NewSpace(mahalanobis.Conf{Covariance: cov})
+	test.AssertNoError(t, err)
+
+	var weighted = mahalanobis.Estimate(data[:2], []int{1, 3})
+	test.AssertAlmostEqual(t, weighted[0][0], weighted[1][1])
+}
@@ -0,0 +1,110 @@
+// Package manhattan allows to computes Manhattan (L1) distance based clusters.
+package manhattan
+
+import (
+	"math"
+	"sort"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+// Space for vectors ([]float64) with Manhattan distance
+type Space struct {
+	vspace euclid.Space
+}
+
+// NewSpace creates a new Space
+func NewSpace() Space {
+	return Space{
+		vspace: euclid.NewSpace(),
+	}
+}
+
+// Dist computes the Manhattan distance between two vectors
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return space.PointDist(elemt1.([]float64), elemt2.([]float64))
+}
+
+// IsMetric returns true since the Manhattan distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
+// PointDist returns the Manhattan distance between two points
+func (space Space) PointDist(point1 []float64, point2 []float64) float64 {
+	var sum = 0.
+	for i := range point1 {
+		sum += math.Abs(point1[i] - point2[i])
+	}
+	return sum
+}
+
+// Combine returns the weighted average of elemt1 and elemt2.
+// Incremental reductions thus compute means, whereas kmeans updates centroids with Median.
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return space.vspace.Combine(elemt1, weight1, elemt2, weight2)
+}
+
+// PointCombine returns the weighted average of points
+func (space Space) PointCombine(point1 []float64, weight1 int, point2 []float64, weight2 int) []float64 {
+	return space.vspace.PointCombine(point1, weight1, point2, weight2)
+}
+
+// Copy creates a copy of a vector
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	return space.vspace.Copy(elemt)
+}
+
+// PointCopy copy points
+func (space Space) PointCopy(point []float64) []float64 {
+	return space.vspace.PointCopy(point)
+}
+
+// Dim returns input data dimension
+func (space Space) Dim(data []core.Elemt) int {
+	return space.vspace.Dim(data)
+}
+
+// Codec returns the vector codec for the given format
+func (space Space) Codec(format core.Format) (core.Codec, error) {
+	return space.vspace.Codec(format)
+}
+
+// Median returns the coordinate-wise weighted median of vectors which minimizes the sum of Manhattan distances.
+// Nil weights stand for unit weights. It returns nil if there is no vector.
+func Median(elemts []core.Elemt, weights []int) []float64 {
+	if len(elemts) == 0 {
+		return nil
+	}
+	var dim = len(elemts[0].([]float64))
+	var median = make([]float64, dim)
+	var order = make([]int, len(elemts))
+	var total = 0
+	for i := range elemts {
+		total += core.Weight(weights, i)
+	}
+	for d := 0; d < dim; d++ {
+		for i := range order {
+			order[i] = i
+		}
+		sort.Slice(order, func(a, b int) bool {
+			return elemts[order[a]].([]float64)[d] < elemts[order[b]].([]float64)[d]
+		})
+		var cum = 0
+		for _, i := range order {
+			cum += core.Weight(weights, i)
+			if 2*cum >= total {
+				median[d] = elemts[i].([]float64)[d]
+				break
+			}
+		}
+	}
+	return median
+}
+
+// Median returns the coordinate-wise weighted median of vectors.
+// It implements kmeans.MedianSpace so that kmeans computes L1 centers (k-medians).
+func (space Space) Median(elemts []core.Elemt, weights []int) core.Elemt {
+	return Median(elemts, weights)
+}
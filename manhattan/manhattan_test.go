@@ -0,0 +1,69 @@
+package manhattan_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dtw"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/manhattan"
+)
+
+var _ dtw.PointSpace = manhattan.Space{}
+var _ kmeans.MedianSpace = manhattan.Space{}
+
+func TestSpace_Dist(t *testing.T) {
+	var space = manhattan.NewSpace()
+	var d = space.Dist([]float64{1., 1.}, []float64{2., -2.})
+	test.AssertAlmostEqual(t, 4., d)
+	test.AssertTrue(t, core.IsMetric(space))
+}
+
+func TestSpace_Combine(t *testing.T) {
+	var space = manhattan.NewSpace()
+	var c = space.Combine([]float64{1., 1.}, 1, []float64{4., -2.}, 2)
+	test.AssertArrayAlmostEqual(t, []float64{3., -1.}, c.([]float64))
+}
+
+func TestMedian(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{1., 10.}, []float64{2., 0.}, []float64{100., 5.}}
+	test.AssertArrayAlmostEqual(t, []float64{2., 5.}, manhattan.Median(elemts, nil))
+	test.AssertArrayAlmostEqual(t, []float64{100., 5.}, manhattan.Median(elemts, []int{1, 1, 3}))
+	if manhattan.Median(nil, nil) != nil {
+		t.Error("Expected nil median")
+	}
+}
+
+func TestSpace_Median(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{1.}, []float64{2.}, []float64{9.}}
+	var median = manhattan.NewSpace().Median(elemts, []int{1, 1, 3})
+	test.AssertArrayAlmostEqual(t, []float64{9.}, median.([]float64))
+}
+
+func TestSpace_InnerSpace(t *testing.T) {
+	var space = dtw.NewSpace(dtw.Conf{InnerSpace: manhattan.NewSpace()})
+	var s1 = [][]float64{{0., 0.}, {1., 1.}}
+	var s2 = [][]float64{{0., 0.}, {1., 1.}, {1., 3.}}
+	test.AssertAlmostEqual(t, 2., space.Dist(s1, s2))
+}
+
+func TestKMedians(t *testing.T) {
+	var space = manhattan.NewSpace()
+	var data = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}, []float64{20}, []float64{21}, []float64{22}}
+	var centroids = core.Clust{[]float64{0}, []float64{21}, []float64{100}}
+	var expected = core.Clust{[]float64{1}, []float64{21}, []float64{100}}
+
+	for _, strategy := range []kmeans.StatsStrategy{
+		&kmeans.SeqStrategy{},
+		&kmeans.SeqStrategy{Bounds: &core.Bounds{}},
+		&kmeans.ParStrategy{Degree: 2},
+		&kmeans.ParStrategy{Degree: 2, Bounds: &core.Bounds{}},
+	} {
+		test.AssertCentroids(t, expected, strategy.Iterate(space, centroids, data, nil))
+		var stats = strategy.Stats()
+		test.AssertEqual(t, 3, stats[0].Size)
+		test.AssertAlmostEqual(t, 101, stats[0].Loss)
+		test.AssertEqual(t, 0, stats[2].Size)
+	}
+}
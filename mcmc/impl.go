@@ -1,6 +1,9 @@
 package mcmc
 
 import (
+	"bytes"
+	"encoding/gob"
+	"io"
 	"math"
 
 	"github.com/wearelumenai/distclus/core"
@@ -29,14 +32,20 @@ type Impl struct {
 // Copy impl
 func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
 	var newConf = model.Conf().(*Conf)
-	var algo = NewAlgo(*newConf, model.Space(), impl.buffer.Data(), impl.initializer, impl.distrib)
-	return algo.Impl(), nil
+	var algo = NewAlgo(*newConf, model.Space(), nil, impl.initializer, impl.distrib)
+	var newImpl = algo.Impl().(*Impl)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return newImpl, nil
 }
 
-// Strategy specifies strategy methods
+// Strategy specifies strategy methods.
+// Weights of data may be nil for unit weights.
 type Strategy interface {
-	Iterate(Conf, core.Space, core.Clust, []core.Elemt, int) core.Clust
-	Loss(Conf, core.Space, core.Clust, []core.Elemt) float64
+	Iterate(Conf, core.Space, core.Clust, []core.Elemt, []int, int) core.Clust
+	Stats(Conf, core.Space, core.Clust, []core.Elemt, []int) []core.ClusterStats
 }
 
 // Init initializes the algorithm
@@ -49,11 +58,13 @@ func (impl *Impl) Init(model core.OCModel) (centroids core.Clust, err error) {
 		var data = impl.buffer.Data()
 		impl.dim = space.Dim(centroids)
 		var currentTime = impl.getCurrentTime(data)
+		var stats = impl.strategy.Stats(*mcmcConf, space, centroids, data, impl.buffer.Weights())
 		impl.current = proposal{
 			k:       mcmcConf.InitK,
 			centers: centroids,
-			loss:    impl.strategy.Loss(*mcmcConf, space, centroids, data),
+			loss:    totalLoss(stats),
 			pdf:     impl.proba(*mcmcConf, space, centroids, centroids, currentTime),
+			stats:   stats,
 		}
 		impl.time = currentTime
 	}
@@ -65,8 +76,9 @@ func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures
 	var mcmcConf = model.Conf().(*Conf)
 
 	var data = impl.buffer.Data()
+	var weights = impl.buffer.Weights()
 	var currentTime = impl.getCurrentTime(data)
-	impl.current, clust = impl.doIter(*mcmcConf, model.Space(), impl.current, model.Centroids(), data, currentTime)
+	impl.current, clust = impl.doIter(*mcmcConf, model.Space(), impl.current, model.Centroids(), data, weights, currentTime)
 	impl.time = currentTime
 	return clust, impl.runtimeFigures(), impl.buffer.Apply()
 }
@@ -80,15 +92,33 @@ func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
 	return impl.buffer.Push(elemt, model.Status().Alive())
 }
 
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) error {
+	return impl.buffer.PushWeighted(elemt, weight, model.Status().Alive())
+}
+
 type proposal struct {
 	k       int
 	centers core.Clust
 	loss    float64
 	pdf     float64
+	stats   []core.ClusterStats
 }
 
-func (impl *Impl) doIter(conf Conf, space core.Space, current proposal, centroids core.Clust, data []core.Elemt, time int) (proposal, core.Clust) {
-	var prop = impl.propose(conf, space, current, centroids, data, time)
+// Stats returns cluster statistics of the current proposal
+func (impl *Impl) Stats() []core.ClusterStats {
+	return impl.current.stats
+}
+
+func totalLoss(stats []core.ClusterStats) (loss float64) {
+	for i := range stats {
+		loss += stats[i].Loss
+	}
+	return
+}
+
+func (impl *Impl) doIter(conf Conf, space core.Space, current proposal, centroids core.Clust, data []core.Elemt, weights []int, time int) (proposal, core.Clust) {
+	var prop = impl.propose(conf, space, current, centroids, data, weights, time)
 
 	if impl.accept(conf, current, prop, time) {
 		current = prop
@@ -100,15 +130,17 @@ func (impl *Impl) doIter(conf Conf, space core.Space, current proposal, centroid
 	return current, centroids
 }
 
-func (impl *Impl) propose(conf Conf, space core.Space, current proposal, centroids core.Clust, data []core.Elemt, time int) proposal {
+func (impl *Impl) propose(conf Conf, space core.Space, current proposal, centroids core.Clust, data []core.Elemt, weights []int, time int) proposal {
 	k, centers := impl.getKCenters(conf, space, current, centroids, data)
 	centers = impl.alter(conf, space, centers, time)
-	centers = impl.strategy.Iterate(conf, space, centers, data, 1)
+	centers = impl.strategy.Iterate(conf, space, centers, data, weights, 1)
+	var stats = impl.strategy.Stats(conf, space, centers, data, weights)
 	return proposal{
 		k:       k,
 		centers: centers,
-		loss:    impl.strategy.Loss(conf, space, centers, data),
+		loss:    totalLoss(stats),
 		pdf:     impl.proba(conf, space, centers, centers, time),
+		stats:   stats,
 	}
 }
 
@@ -182,3 +214,74 @@ func (impl *Impl) runtimeFigures() core.RuntimeFigures {
 		Time:         float64(impl.time),
 	}
 }
+
+// implState is the persisted form of an Impl
+type implState struct {
+	Buffer  []byte
+	Centers map[int]core.Clust
+	Acc     int
+	Lambda  float64
+	Rho     float64
+	RGibbs  float64
+	Time    int
+	Dim     int
+	K       int
+	Current core.Clust
+	Loss    float64
+	Pdf     float64
+	Stats   []core.ClusterStats
+}
+
+// Save writes buffered data, stored centers, acceptance counters and current proposal.
+// The random generator state is not saved.
+func (impl *Impl) Save(w io.Writer) (err error) {
+	var buffer bytes.Buffer
+	err = impl.buffer.Save(&buffer)
+	if err == nil {
+		err = gob.NewEncoder(w).Encode(implState{
+			Buffer:  buffer.Bytes(),
+			Centers: impl.store.centers,
+			Acc:     impl.acc,
+			Lambda:  impl.lambda,
+			Rho:     impl.rho,
+			RGibbs:  impl.rGibbs,
+			Time:    impl.time,
+			Dim:     impl.dim,
+			K:       impl.current.k,
+			Current: impl.current.centers,
+			Loss:    impl.current.loss,
+			Pdf:     impl.current.pdf,
+			Stats:   impl.current.stats,
+		})
+	}
+	return
+}
+
+// Load replaces the implementation state with a saved one
+func (impl *Impl) Load(r io.Reader) (err error) {
+	var state implState
+	err = gob.NewDecoder(r).Decode(&state)
+	if err == nil {
+		err = impl.buffer.Load(bytes.NewReader(state.Buffer))
+	}
+	if err == nil {
+		impl.store.centers = state.Centers
+		if impl.store.centers == nil {
+			impl.store.centers = map[int]core.Clust{}
+		}
+		impl.acc = state.Acc
+		impl.lambda = state.Lambda
+		impl.rho = state.Rho
+		impl.rGibbs = state.RGibbs
+		impl.time = state.Time
+		impl.dim = state.Dim
+		impl.current = proposal{
+			k:       state.K,
+			centers: state.Current,
+			loss:    state.Loss,
+			pdf:     state.Pdf,
+			stats:   state.Stats,
+		}
+	}
+	return
+}
@@ -1,6 +1,7 @@
 package mcmc_test
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -57,3 +58,45 @@ func Test_DistinctValuesMaxK(t *testing.T) {
 		}
 	}
 }
+
+func Test_Snapshot(t *testing.T) {
+	var implConf = mcmc.Conf{
+		InitK:    2,
+		Amp:      10,
+		B:        .00001,
+		MaxK:     50,
+		CtrlConf: core.CtrlConf{Iter: 200},
+	}
+	var distrib = mcmc.NewMultivT(mcmc.MultivTConf{Dim: 1})
+	var algo = mcmc.NewAlgo(implConf, euclid.Space{}, ints, kmeans.GivenInitializer, distrib)
+	_ = algo.Init()
+	_ = algo.Play()
+	_ = algo.Wait(nil, 0)
+
+	var buffer bytes.Buffer
+	if err := algo.Snapshot(&buffer); err != nil {
+		t.Error("no error expected", err)
+	}
+
+	var impl = mcmc.NewSeqImpl(implConf, kmeans.GivenInitializer, nil, distrib)
+	var restored, err = core.Restore(&buffer, &implConf, &impl, euclid.Space{})
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if !reflect.DeepEqual(algo.Centroids(), restored.Centroids()) {
+		t.Error("same centroids expected")
+	}
+	if !reflect.DeepEqual(algo.RuntimeFigures(), restored.RuntimeFigures()) {
+		t.Error("same runtime figures expected")
+	}
+
+	_ = restored.Play()
+	_ = restored.Wait(nil, 0)
+	var figures = restored.RuntimeFigures()
+	if figures[mcmc.Acceptations] < algo.RuntimeFigures()[mcmc.Acceptations] {
+		t.Error("acceptations should be kept", figures)
+	}
+	if figures[mcmc.Time] != float64(len(ints)) {
+		t.Error("buffered data should be kept", figures)
+	}
+}
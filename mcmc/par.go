@@ -20,27 +20,22 @@ type ParStrategy struct {
 }
 
 // Iterate is the iterative execution
-func (strategy *ParStrategy) Iterate(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt, iter int) (result core.Clust) {
-	var kmeansConf = kmeans.Conf{
-		Par: true,
-		K:   len(centroids),
-		CtrlConf: core.CtrlConf{
-			Iter: iter,
-		},
-	}
-	core.PrepareConf(&kmeansConf)
-	var model = core.NewSimpleOCModel(&kmeansConf, space, core.NewOCStatus(core.Created), core.RuntimeFigures{}, result)
-	var impl = kmeans.NewParImpl(kmeansConf, centroids.Initializer, data)
-	result, _ = impl.Init(model)
+func (strategy *ParStrategy) Iterate(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt, weights []int, iter int) (result core.Clust) {
+	var kmeansStrategy = kmeans.ParStrategy{Degree: strategy.Degree}
+	result = centroids
 	for i := 0; i < iter; i++ {
-		model = core.NewSimpleOCModel(&kmeansConf, space, core.NewOCStatus(core.Created), core.RuntimeFigures{}, result)
-		result, _, _ = impl.Iterate(model)
+		result = kmeansStrategy.Iterate(space, result, data, weights)
 	}
 
 	return
 }
 
 // Loss calculates loss for the given proposal and data in parallel
-func (strategy *ParStrategy) Loss(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt) float64 {
-	return centroids.ParTotalLoss(data, space, conf.Norm, strategy.Degree)
+func (strategy *ParStrategy) Loss(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt, weights []int) float64 {
+	return centroids.ParWeightedTotalLoss(data, weights, space, conf.Norm, strategy.Degree)
+}
+
+// Stats calculates cluster statistics for the given proposal and data in parallel
+func (strategy *ParStrategy) Stats(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt, weights []int) []core.ClusterStats {
+	return centroids.ParReduceStats(data, weights, space, conf.Norm, strategy.Degree)
 }
@@ -109,7 +109,7 @@ func TestParStrategy_Loss(t *testing.T) {
 	strategy.Degree = runtime.NumCPU()
 
 	var clust = algo.Centroids()
-	var l1 = strategy.Loss(implConf, algo.Space(), clust, buffer.Data())
+	var l1 = strategy.Loss(implConf, algo.Space(), clust, buffer.Data(), buffer.Weights())
 	var l2 = clust.TotalLoss(test.Vectors, algo.Space(), implConf.Norm)
 
 	if math.Abs(l1-l2) > 1e-6 {
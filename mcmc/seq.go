@@ -24,27 +24,22 @@ type SeqStrategy struct {
 }
 
 // Iterate execute the algorithm
-func (strategy *SeqStrategy) Iterate(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt, iter int) (result core.Clust) {
-	var kmeansConf = kmeans.Conf{
-		K:    len(centroids),
-		RGen: conf.RGen,
-		CtrlConf: core.CtrlConf{
-			Iter: iter,
-		},
-	}
-	core.PrepareConf(&kmeansConf)
-	var model = core.NewSimpleOCModel(&kmeansConf, space, core.NewOCStatus(core.Created), core.RuntimeFigures{}, result)
-	var impl = kmeans.NewParImpl(kmeansConf, centroids.Initializer, data)
-	result, _ = impl.Init(model)
+func (strategy *SeqStrategy) Iterate(conf Conf, space core.Space, centroids core.Clust, data []core.Elemt, weights []int, iter int) (result core.Clust) {
+	var kmeansStrategy = kmeans.SeqStrategy{}
+	result = centroids
 	for i := 0; i < iter; i++ {
-		model = core.NewSimpleOCModel(&kmeansConf, space, core.NewOCStatus(core.Created), core.RuntimeFigures{}, result)
-		result, _, _ = impl.Iterate(model)
+		result = kmeansStrategy.Iterate(space, result, data, weights)
 	}
 
 	return
 }
 
 // Loss calculates loss for the given proposal and data
-func (strategy *SeqStrategy) Loss(conf Conf, space core.Space, proposal core.Clust, data []core.Elemt) float64 {
-	return proposal.TotalLoss(data, space, conf.Norm)
+func (strategy *SeqStrategy) Loss(conf Conf, space core.Space, proposal core.Clust, data []core.Elemt, weights []int) float64 {
+	return proposal.WeightedTotalLoss(data, weights, space, conf.Norm)
+}
+
+// Stats calculates cluster statistics for the given proposal and data
+func (strategy *SeqStrategy) Stats(conf Conf, space core.Space, proposal core.Clust, data []core.Elemt, weights []int) []core.ClusterStats {
+	return proposal.ReduceStats(data, weights, space, conf.Norm)
 }
@@ -184,3 +184,51 @@ func Test_AcceptRatio(t *testing.T) {
 		t.Error("Expected ratio in [0 1], got", r)
 	}
 }
+
+func TestSeqStrategy_Weighted(t *testing.T) {
+	var conf = mcmc.Conf{Norm: 2}
+	var centroids = core.Clust(test.Vectors[:3])
+	var weights = []int{1, 2, 3, 1, 2, 3, 1, 2}
+	var duplicated []core.Elemt
+	for i, elemt := range test.Vectors {
+		for j := 0; j < weights[i]; j++ {
+			duplicated = append(duplicated, elemt)
+		}
+	}
+
+	var strategy = mcmc.SeqStrategy{}
+	test.AssertAlmostEqual(t,
+		strategy.Loss(conf, space, centroids, duplicated, nil),
+		strategy.Loss(conf, space, centroids, test.Vectors, weights),
+	)
+	test.AssertCentroids(t,
+		strategy.Iterate(conf, space, centroids, duplicated, nil, 2),
+		strategy.Iterate(conf, space, centroids, test.Vectors, weights, 2),
+	)
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = mcmc.Conf{
+		InitK:    3,
+		RGen:     rand.New(rand.NewSource(6305689164243)),
+		B:        100,
+		Amp:      1,
+		CtrlConf: core.CtrlConf{Iter: 20},
+	}
+	var distrib = mcmc.NewMultivT(mcmc.MultivTConf{Dim: 5, Nu: 3})
+	var algo = mcmc.NewAlgo(conf, space, test.Vectors, kmeans.GivenInitializer, distrib)
+	test.AssertNoError(t, algo.Batch())
+
+	var centroids = algo.Centroids()
+	var stats = algo.Stats()
+	var expected = centroids.ReduceStats(test.Vectors, nil, space, 2.)
+	if len(stats) != len(expected) {
+		t.Error("Expected", expected, "got", stats)
+	}
+	for i := range expected {
+		if stats[i].Size != expected[i].Size {
+			t.Error("Expected", expected[i], "got", stats[i])
+		}
+		test.AssertAlmostEqual(t, expected[i].Loss, stats[i].Loss)
+	}
+}
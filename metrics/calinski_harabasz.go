@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"github.com/wearelumenai/distclus/core"
+)
+
+// CalinskiHarabasz returns the Calinski-Harabasz index (variance ratio criterion)
+// of elements assigned to their nearest centroid.
+// Higher values indicate denser and better separated clusters.
+func CalinskiHarabasz(elemts []core.Elemt, clust core.Clust, space core.Space) (ch float64, err error) {
+	var losses, cards = clust.ReduceLoss(elemts, space, 2)
+	var mean core.Elemt
+	if len(elemts) > 0 {
+		mean, err = core.DBA(elemts, space)
+	}
+	if err == nil {
+		ch, err = calinskiHarabasz(clust, space, mean, losses, cards)
+	}
+	return
+}
+
+// ParCalinskiHarabasz computes the Calinski-Harabasz index in parallel
+func ParCalinskiHarabasz(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (float64, error) {
+	var losses, cards = clust.ParReduceLoss(elemts, space, 2, degree)
+	var mean core.Elemt
+	if len(elemts) > 0 {
+		var single = core.Clust{elemts[0]}
+		var means, _ = single.ParReduceDBA(elemts, space, degree)
+		mean = means[0]
+	}
+	return calinskiHarabasz(clust, space, mean, losses, cards)
+}
+
+func calinskiHarabasz(clust core.Clust, space core.Space, mean core.Elemt, losses []float64, cards []int) (ch float64, err error) {
+	if err = checkClusters(cards); err != nil {
+		return
+	}
+	var k = countClusters(cards)
+	var n int
+	var within, between float64
+	for i := range clust {
+		if cards[i] > 0 {
+			var dist = space.Dist(clust[i], mean)
+			between += float64(cards[i]) * dist * dist
+			within += losses[i]
+			n += cards[i]
+		}
+	}
+	if n <= k {
+		return 0, ErrElemts
+	}
+	return between / float64(k-1) / (within / float64(n-k)), nil
+}
@@ -0,0 +1,32 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/metrics"
+)
+
+func TestCalinskiHarabasz(t *testing.T) {
+	var index, err = metrics.CalinskiHarabasz(points, centers, space)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 200, index)
+}
+
+func TestParCalinskiHarabasz(t *testing.T) {
+	var data, clust = vectors()
+	var seq, _ = metrics.CalinskiHarabasz(data, clust, space)
+	for degree := 1; degree < 20; degree++ {
+		var par, err = metrics.ParCalinskiHarabasz(data, clust, space, degree)
+		test.AssertNoError(t, err)
+		test.AssertAlmostEqual(t, seq, par)
+	}
+}
+
+func TestCalinskiHarabaszClusters(t *testing.T) {
+	var _, err = metrics.CalinskiHarabasz(points, core.Clust{[]float64{0}}, space)
+	if err != metrics.ErrClusters {
+		t.Error("clusters error expected", err)
+	}
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// DaviesBouldin returns the Davies-Bouldin index of elements assigned to their nearest centroid.
+// Lower values indicate better separated clusters. Empty clusters are ignored.
+func DaviesBouldin(elemts []core.Elemt, clust core.Clust, space core.Space) (float64, error) {
+	var losses, cards = clust.ReduceLoss(elemts, space, 1)
+	return daviesBouldin(clust, space, losses, cards)
+}
+
+// ParDaviesBouldin computes the Davies-Bouldin index in parallel
+func ParDaviesBouldin(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (float64, error) {
+	var losses, cards = clust.ParReduceLoss(elemts, space, 1, degree)
+	return daviesBouldin(clust, space, losses, cards)
+}
+
+func daviesBouldin(clust core.Clust, space core.Space, losses []float64, cards []int) (db float64, err error) {
+	if err = checkClusters(cards); err != nil {
+		return
+	}
+	var scatters = make([]float64, len(clust))
+	for k := range clust {
+		if cards[k] > 0 {
+			scatters[k] = losses[k] / float64(cards[k])
+		}
+	}
+	for k := range clust {
+		if cards[k] == 0 {
+			continue
+		}
+		var max = 0.
+		for j := range clust {
+			if j != k && cards[j] > 0 {
+				var ratio = (scatters[k] + scatters[j]) / space.Dist(clust[k], clust[j])
+				max = math.Max(max, ratio)
+			}
+		}
+		db += max
+	}
+	return db / float64(countClusters(cards)), nil
+}
@@ -0,0 +1,32 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/metrics"
+)
+
+func TestDaviesBouldin(t *testing.T) {
+	var index, err = metrics.DaviesBouldin(points, centers, space)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 0.1, index)
+}
+
+func TestParDaviesBouldin(t *testing.T) {
+	var data, clust = vectors()
+	var seq, _ = metrics.DaviesBouldin(data, clust, space)
+	for degree := 1; degree < 20; degree++ {
+		var par, err = metrics.ParDaviesBouldin(data, clust, space, degree)
+		test.AssertNoError(t, err)
+		test.AssertAlmostEqual(t, seq, par)
+	}
+}
+
+func TestDaviesBouldinClusters(t *testing.T) {
+	var _, err = metrics.DaviesBouldin(points, core.Clust{[]float64{0}}, space)
+	if err != metrics.ErrClusters {
+		t.Error("clusters error expected", err)
+	}
+}
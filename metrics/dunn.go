@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Dunn returns the Dunn index of elements assigned to their nearest centroid,
+// i.e. the minimal distance between elements of distinct clusters
+// divided by the maximal distance between elements of a same cluster.
+// Higher values indicate compact and well separated clusters.
+func Dunn(elemts []core.Elemt, clust core.Clust, space core.Space) (float64, error) {
+	return dunn(elemts, clust, space, 1)
+}
+
+// ParDunn computes the Dunn index in parallel
+func ParDunn(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (float64, error) {
+	return dunn(elemts, clust, space, degree)
+}
+
+type dunnPartition struct {
+	separation float64
+	diameter   float64
+}
+
+func dunn(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (index float64, err error) {
+	var labels = mapLabel(elemts, clust, space, degree)
+	if err = checkClusters(labelCards(labels, len(clust))); err != nil {
+		return
+	}
+	var parts = make([]dunnPartition, degree)
+	var process = func(start int, end int, rank int) {
+		parts[rank] = dunnReduce(elemts, labels, space, start, end)
+	}
+	if degree > 1 {
+		core.Par(process, len(elemts), degree)
+	} else {
+		process(0, len(elemts), 0)
+	}
+	var aggr = dunnPartition{separation: math.Inf(1)}
+	for _, part := range parts {
+		aggr.separation = math.Min(aggr.separation, part.separation)
+		aggr.diameter = math.Max(aggr.diameter, part.diameter)
+	}
+	if aggr.diameter == 0 {
+		return math.Inf(1), nil
+	}
+	return aggr.separation / aggr.diameter, nil
+}
+
+// dunnReduce computes separation and diameter over pairs whose first element is in [start, end)
+func dunnReduce(elemts []core.Elemt, labels []int, space core.Space, start int, end int) dunnPartition {
+	var part = dunnPartition{separation: math.Inf(1)}
+	for i := start; i < end; i++ {
+		for j := i + 1; j < len(elemts); j++ {
+			var dist = space.Dist(elemts[i], elemts[j])
+			if labels[i] == labels[j] {
+				part.diameter = math.Max(part.diameter, dist)
+			} else {
+				part.separation = math.Min(part.separation, dist)
+			}
+		}
+	}
+	return part
+}
@@ -0,0 +1,201 @@
+package metrics
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrLabels raised when predicted and reference labels have different or null lengths
+var ErrLabels = errors.New("predicted and reference labels must have the same non null length")
+
+// Contingency is the contingency matrix of predicted clusters (rows) and reference classes (columns)
+type Contingency struct {
+	Clusters []int   // predicted labels in row order
+	Classes  []int   // reference labels in column order
+	Counts   [][]int // number of elements in each cluster and class
+	Sizes    []int   // number of elements in each cluster
+	Supports []int   // number of elements in each class
+	N        int     // number of elements
+}
+
+// NewContingency builds the contingency matrix of predicted labels, e.g. from core.Clust.MapLabel,
+// and reference labels. Labels can be any integers.
+func NewContingency(predicted []int, reference []int) (contingency Contingency, err error) {
+	if len(predicted) != len(reference) || len(predicted) == 0 {
+		err = ErrLabels
+		return
+	}
+	var clusters, rows = index(predicted)
+	var classes, cols = index(reference)
+	contingency = Contingency{
+		Clusters: clusters,
+		Classes:  classes,
+		Counts:   make([][]int, len(clusters)),
+		Sizes:    make([]int, len(clusters)),
+		Supports: make([]int, len(classes)),
+		N:        len(predicted),
+	}
+	for i := range contingency.Counts {
+		contingency.Counts[i] = make([]int, len(classes))
+	}
+	for i := range predicted {
+		var row, col = rows[predicted[i]], cols[reference[i]]
+		contingency.Counts[row][col]++
+		contingency.Sizes[row]++
+		contingency.Supports[col]++
+	}
+	return
+}
+
+// index returns sorted distinct labels and their positions
+func index(labels []int) (distinct []int, positions map[int]int) {
+	positions = map[int]int{}
+	for _, label := range labels {
+		if _, ok := positions[label]; !ok {
+			positions[label] = 0
+			distinct = append(distinct, label)
+		}
+	}
+	sort.Ints(distinct)
+	for i, label := range distinct {
+		positions[label] = i
+	}
+	return
+}
+
+// AdjustedRandIndex returns the Rand index adjusted for chance between predicted and reference labels.
+// 1 means identical partitions, values close to 0 mean random labeling.
+func AdjustedRandIndex(predicted []int, reference []int) (ari float64, err error) {
+	var contingency Contingency
+	if contingency, err = NewContingency(predicted, reference); err == nil {
+		ari = contingency.AdjustedRandIndex()
+	}
+	return
+}
+
+// AdjustedRandIndex returns the adjusted Rand index of the contingency matrix.
+// Partitions of a single element are identical and their index is 1.
+func (contingency Contingency) AdjustedRandIndex() float64 {
+	if contingency.N < 2 {
+		return 1
+	}
+	var sumPairs, sizePairs, supportPairs float64
+	for i, row := range contingency.Counts {
+		for _, count := range row {
+			sumPairs += pairs(count)
+		}
+		sizePairs += pairs(contingency.Sizes[i])
+	}
+	for _, support := range contingency.Supports {
+		supportPairs += pairs(support)
+	}
+	var expected = sizePairs * supportPairs / pairs(contingency.N)
+	var max = (sizePairs + supportPairs) / 2
+	if max == expected {
+		return 1
+	}
+	return (sumPairs - expected) / (max - expected)
+}
+
+func pairs(n int) float64 {
+	return float64(n) * float64(n-1) / 2
+}
+
+// NormalizedMutualInfo returns the mutual information between predicted and reference labels
+// normalized by the arithmetic mean of their entropies.
+func NormalizedMutualInfo(predicted []int, reference []int) (nmi float64, err error) {
+	var contingency Contingency
+	if contingency, err = NewContingency(predicted, reference); err == nil {
+		nmi = contingency.NormalizedMutualInfo()
+	}
+	return
+}
+
+// NormalizedMutualInfo returns the normalized mutual information of the contingency matrix
+func (contingency Contingency) NormalizedMutualInfo() float64 {
+	var hClusters = entropy(contingency.Sizes, contingency.N)
+	var hClasses = entropy(contingency.Supports, contingency.N)
+	var mean = (hClusters + hClasses) / 2
+	if mean == 0 {
+		return 1
+	}
+	return contingency.MutualInfo() / mean
+}
+
+// MutualInfo returns the mutual information (in nats) of the contingency matrix
+func (contingency Contingency) MutualInfo() (mi float64) {
+	var n = float64(contingency.N)
+	for i, row := range contingency.Counts {
+		for j, count := range row {
+			if count > 0 {
+				var nij = float64(count)
+				var ai = float64(contingency.Sizes[i])
+				var bj = float64(contingency.Supports[j])
+				mi += nij / n * math.Log(n*nij/(ai*bj))
+			}
+		}
+	}
+	return math.Max(mi, 0)
+}
+
+func entropy(counts []int, n int) (h float64) {
+	for _, count := range counts {
+		if count > 0 {
+			var p = float64(count) / float64(n)
+			h -= p * math.Log(p)
+		}
+	}
+	return
+}
+
+// VMeasure returns homogeneity, completeness and their harmonic mean between predicted and reference labels.
+// Homogeneity is 1 if each cluster contains only elements of a single class,
+// completeness is 1 if all elements of a class are in the same cluster.
+func VMeasure(predicted []int, reference []int) (homogeneity, completeness, v float64, err error) {
+	var contingency Contingency
+	if contingency, err = NewContingency(predicted, reference); err == nil {
+		homogeneity, completeness, v = contingency.VMeasure()
+	}
+	return
+}
+
+// VMeasure returns homogeneity, completeness and V-measure of the contingency matrix
+func (contingency Contingency) VMeasure() (homogeneity, completeness, v float64) {
+	var mi = contingency.MutualInfo()
+	homogeneity, completeness = 1, 1
+	if hClasses := entropy(contingency.Supports, contingency.N); hClasses > 0 {
+		homogeneity = mi / hClasses
+	}
+	if hClusters := entropy(contingency.Sizes, contingency.N); hClusters > 0 {
+		completeness = mi / hClusters
+	}
+	if homogeneity+completeness > 0 {
+		v = 2 * homogeneity * completeness / (homogeneity + completeness)
+	}
+	return
+}
+
+// Purity returns the fraction of elements that belong to the majority class of their cluster
+func Purity(predicted []int, reference []int) (purity float64, err error) {
+	var contingency Contingency
+	if contingency, err = NewContingency(predicted, reference); err == nil {
+		purity = contingency.Purity()
+	}
+	return
+}
+
+// Purity returns the purity of the contingency matrix
+func (contingency Contingency) Purity() float64 {
+	var sum int
+	for _, row := range contingency.Counts {
+		var max int
+		for _, count := range row {
+			if count > max {
+				max = count
+			}
+		}
+		sum += max
+	}
+	return float64(sum) / float64(contingency.N)
+}
@@ -0,0 +1,85 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/metrics"
+)
+
+var predicted = []int{0, 0, 1, 1}
+
+var reference = []int{0, 0, 1, 2}
+
+func TestNewContingency(t *testing.T) {
+	var contingency, err = metrics.NewContingency([]int{3, 3, -1, 3}, []int{1, 0, 1, 1})
+	test.AssertNoError(t, err)
+	var expected = metrics.Contingency{
+		Clusters: []int{-1, 3},
+		Classes:  []int{0, 1},
+		Counts:   [][]int{{0, 1}, {1, 2}},
+		Sizes:    []int{1, 3},
+		Supports: []int{1, 3},
+		N:        4,
+	}
+	if !reflect.DeepEqual(expected, contingency) {
+		t.Error("Expected", expected, "got", contingency)
+	}
+}
+
+func TestNewContingency_Error(t *testing.T) {
+	if _, err := metrics.NewContingency([]int{0}, []int{0, 1}); err != metrics.ErrLabels {
+		t.Error("labels error expected", err)
+	}
+	if _, err := metrics.NewContingency(nil, nil); err != metrics.ErrLabels {
+		t.Error("labels error expected", err)
+	}
+}
+
+func TestAdjustedRandIndex(t *testing.T) {
+	var ari, err = metrics.AdjustedRandIndex(predicted, reference)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 0.5714285714285715, ari)
+
+	ari, _ = metrics.AdjustedRandIndex(predicted, []int{1, 1, 0, 0})
+	test.AssertAlmostEqual(t, 1, ari)
+
+	ari, _ = metrics.AdjustedRandIndex([]int{0, 0, 0, 0}, []int{0, 0, 0, 0})
+	test.AssertAlmostEqual(t, 1, ari)
+}
+
+func TestAdjustedRandIndex_Single(t *testing.T) {
+	var ari, err = metrics.AdjustedRandIndex([]int{3}, []int{0})
+	test.AssertNoError(t, err)
+	if ari != 1 {
+		t.Error("Expected 1 got", ari)
+	}
+}
+
+func TestNormalizedMutualInfo(t *testing.T) {
+	var nmi, err = metrics.NormalizedMutualInfo(predicted, reference)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 0.8, nmi)
+
+	nmi, _ = metrics.NormalizedMutualInfo(predicted, []int{1, 1, 0, 0})
+	test.AssertAlmostEqual(t, 1, nmi)
+}
+
+func TestVMeasure(t *testing.T) {
+	var h, c, v, err = metrics.VMeasure(predicted, reference)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 2./3, h)
+	test.AssertAlmostEqual(t, 1, c)
+	test.AssertAlmostEqual(t, 0.8, v)
+}
+
+func TestPurity(t *testing.T) {
+	var purity, err = metrics.Purity(predicted, reference)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 0.75, purity)
+
+	if _, err = metrics.Purity(predicted, nil); err != metrics.ErrLabels {
+		t.Error("labels error expected", err)
+	}
+}
@@ -0,0 +1,53 @@
+// Package metrics computes clustering quality indexes.
+// Internal indexes evaluate centroids against the clustered elements,
+// external indexes compare predicted labels with reference labels.
+package metrics
+
+import (
+	"errors"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// ErrClusters raised when an index needs more non empty clusters than given
+var ErrClusters = errors.New("at least 2 non empty clusters are needed")
+
+// ErrElemts raised when an index needs more elements than given
+var ErrElemts = errors.New("more elements than clusters are needed")
+
+// countClusters returns the number of non empty clusters
+func countClusters(cards []int) (k int) {
+	for _, card := range cards {
+		if card > 0 {
+			k++
+		}
+	}
+	return
+}
+
+// checkClusters verifies that at least 2 clusters are not empty
+func checkClusters(cards []int) (err error) {
+	if countClusters(cards) < 2 {
+		err = ErrClusters
+	}
+	return
+}
+
+// labelCards returns cluster cardinalities from labels
+func labelCards(labels []int, k int) []int {
+	var cards = make([]int, k)
+	for _, label := range labels {
+		cards[label]++
+	}
+	return cards
+}
+
+// mapLabel assigns elements to centroids, in parallel if degree is greater than 1
+func mapLabel(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (labels []int) {
+	if degree > 1 {
+		labels, _ = clust.ParMapLabel(elemts, space, degree)
+	} else {
+		labels, _ = clust.MapLabel(elemts, space)
+	}
+	return
+}
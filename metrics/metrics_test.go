@@ -0,0 +1,22 @@
+package metrics_test
+
+import (
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+)
+
+var space = euclid.Space{}
+
+var points = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}, []float64{11}}
+
+var centers = core.Clust{[]float64{.5}, []float64{10.5}}
+
+func vectors() (data []core.Elemt, clust core.Clust) {
+	data = make([]core.Elemt, 0, len(test.Vectors)*20)
+	for i := 0; i < 20; i++ {
+		data = append(data, test.Vectors...)
+	}
+	clust = core.Clust(test.Vectors[0:3])
+	return
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Silhouette returns the mean silhouette coefficient of elements assigned to their nearest centroid.
+// The coefficient of an element in a singleton cluster is 0.
+func Silhouette(elemts []core.Elemt, clust core.Clust, space core.Space) (float64, error) {
+	return silhouette(elemts, clust, space, 1)
+}
+
+// ParSilhouette computes the mean silhouette coefficient in parallel
+func ParSilhouette(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (float64, error) {
+	return silhouette(elemts, clust, space, degree)
+}
+
+func silhouette(elemts []core.Elemt, clust core.Clust, space core.Space, degree int) (s float64, err error) {
+	var labels = mapLabel(elemts, clust, space, degree)
+	var cards = labelCards(labels, len(clust))
+	if err = checkClusters(cards); err != nil {
+		return
+	}
+	var sums = make([]float64, degree)
+	var process = func(start int, end int, rank int) {
+		var dists = make([]float64, len(clust))
+		for i := start; i < end; i++ {
+			sums[rank] += coefficient(i, elemts, labels, cards, space, dists)
+		}
+	}
+	if degree > 1 {
+		core.Par(process, len(elemts), degree)
+	} else {
+		process(0, len(elemts), 0)
+	}
+	for _, sum := range sums {
+		s += sum
+	}
+	return s / float64(len(elemts)), nil
+}
+
+// coefficient returns the silhouette coefficient of the ith element.
+// dists is a buffer for the sum of distances to each cluster.
+func coefficient(i int, elemts []core.Elemt, labels []int, cards []int, space core.Space, dists []float64) float64 {
+	var label = labels[i]
+	if cards[label] < 2 {
+		return 0
+	}
+	for k := range dists {
+		dists[k] = 0
+	}
+	for j, elemt := range elemts {
+		if j != i {
+			dists[labels[j]] += space.Dist(elemts[i], elemt)
+		}
+	}
+	var a = dists[label] / float64(cards[label]-1)
+	var b = math.Inf(1)
+	for k, dist := range dists {
+		if k != label && cards[k] > 0 {
+			b = math.Min(b, dist/float64(cards[k]))
+		}
+	}
+	if a == b {
+		return 0
+	}
+	return (b - a) / math.Max(a, b)
+}
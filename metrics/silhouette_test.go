@@ -0,0 +1,32 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/metrics"
+)
+
+func TestSilhouette(t *testing.T) {
+	var index, err = metrics.Silhouette(points, centers, space)
+	test.AssertNoError(t, err)
+	test.AssertAlmostEqual(t, 0.8997493734335839, index)
+}
+
+func TestParSilhouette(t *testing.T) {
+	var data, clust = vectors()
+	var seq, _ = metrics.Silhouette(data, clust, space)
+	for degree := 1; degree < 20; degree++ {
+		var par, err = metrics.ParSilhouette(data, clust, space, degree)
+		test.AssertNoError(t, err)
+		test.AssertAlmostEqual(t, seq, par)
+	}
+}
+
+func TestSilhouetteClusters(t *testing.T) {
+	var _, err = metrics.Silhouette(points, core.Clust{[]float64{0}}, space)
+	if err != metrics.ErrClusters {
+		t.Error("clusters error expected", err)
+	}
+}
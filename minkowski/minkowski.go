@@ -0,0 +1,93 @@
+// Package minkowski allows to computes Minkowski distance based clusters.
+package minkowski
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+)
+
+// Conf defines the Minkowski distance order
+type Conf struct {
+	P float64 // order of the distance, 1 for Manhattan, 2 for Euclid, +Inf for Chebyshev
+}
+
+// Space for vectors ([]float64) with Minkowski distance
+type Space struct {
+	p      float64
+	vspace euclid.Space
+}
+
+// NewSpace creates a new Space, the order defaults to 2 if it is not positive
+func NewSpace(conf Conf) Space {
+	var p = conf.P
+	if p <= 0 {
+		p = 2
+	}
+	return Space{
+		p:      p,
+		vspace: euclid.NewSpace(),
+	}
+}
+
+// P returns the order of the distance
+func (space Space) P() float64 {
+	return space.p
+}
+
+// Dist computes the Minkowski distance between two vectors
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return space.PointDist(elemt1.([]float64), elemt2.([]float64))
+}
+
+// IsMetric returns true if the order is at least 1, the triangle inequality does not hold otherwise
+func (space Space) IsMetric() bool {
+	return space.p >= 1
+}
+
+// PointDist returns the Minkowski distance between two points
+func (space Space) PointDist(point1 []float64, point2 []float64) float64 {
+	if math.IsInf(space.p, 1) {
+		var max = 0.
+		for i := range point1 {
+			max = math.Max(max, math.Abs(point1[i]-point2[i]))
+		}
+		return max
+	}
+	var sum = 0.
+	for i := range point1 {
+		sum += math.Pow(math.Abs(point1[i]-point2[i]), space.p)
+	}
+	return math.Pow(sum, 1/space.p)
+}
+
+// Combine returns the weighted average of elemt1 and elemt2
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return space.vspace.Combine(elemt1, weight1, elemt2, weight2)
+}
+
+// PointCombine returns the weighted average of points
+func (space Space) PointCombine(point1 []float64, weight1 int, point2 []float64, weight2 int) []float64 {
+	return space.vspace.PointCombine(point1, weight1, point2, weight2)
+}
+
+// Copy creates a copy of a vector
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	return space.vspace.Copy(elemt)
+}
+
+// PointCopy copy points
+func (space Space) PointCopy(point []float64) []float64 {
+	return space.vspace.PointCopy(point)
+}
+
+// Dim returns input data dimension
+func (space Space) Dim(data []core.Elemt) int {
+	return space.vspace.Dim(data)
+}
+
+// Codec returns the vector codec for the given format
+func (space Space) Codec(format core.Format) (core.Codec, error) {
+	return space.vspace.Codec(format)
+}
@@ -0,0 +1,33 @@
+package minkowski_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/dtw"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/minkowski"
+)
+
+var _ dtw.PointSpace = minkowski.Space{}
+
+func TestSpace_Dist(t *testing.T) {
+	var v1, v2 = []float64{1., 1.}, []float64{4., -3.}
+
+	test.AssertAlmostEqual(t, 7., minkowski.NewSpace(minkowski.Conf{P: 1}).Dist(v1, v2))
+	test.AssertAlmostEqual(t, euclid.NewSpace().Dist(v1, v2), minkowski.NewSpace(minkowski.Conf{}).Dist(v1, v2))
+	test.AssertAlmostEqual(t, math.Cbrt(91.), minkowski.NewSpace(minkowski.Conf{P: 3}).Dist(v1, v2))
+	test.AssertAlmostEqual(t, 4., minkowski.NewSpace(minkowski.Conf{P: math.Inf(1)}).Dist(v1, v2))
+}
+
+func TestSpace_IsMetric(t *testing.T) {
+	test.AssertTrue(t, core.IsMetric(minkowski.NewSpace(minkowski.Conf{P: 1})))
+	test.AssertTrue(t, core.IsMetric(minkowski.NewSpace(minkowski.Conf{P: math.Inf(1)})))
+	test.AssertFalse(t, core.IsMetric(minkowski.NewSpace(minkowski.Conf{P: .5})))
+}
+
+func TestSpace_Default(t *testing.T) {
+	test.AssertAlmostEqual(t, 2., minkowski.NewSpace(minkowski.Conf{P: -1}).P())
+}
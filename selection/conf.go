@@ -0,0 +1,55 @@
+package selection
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+// Conf of the selection of K.
+// The kmeans configuration is used for every K in [MinK, MaxK], its K being ignored.
+type Conf struct {
+	kmeans.Conf
+	MinK       int
+	MaxK       int
+	Criterion  Criterion
+	References int // number of reference datasets of the gap statistic, 10 if 0
+	Degree     int // maximal number of algorithms run in parallel, the number of CPU if 0
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	switch {
+	case conf.MinK < 1:
+		err = fmt.Errorf("Illegal value for MinK: %v", conf.MinK)
+	case conf.MaxK < conf.MinK:
+		err = fmt.Errorf("Illegal value for MaxK: %v", conf.MaxK)
+	case conf.Criterion < Elbow || conf.Criterion > Silhouette:
+		err = fmt.Errorf("Illegal value for Criterion: %v", conf.Criterion)
+	case conf.References < 1:
+		err = fmt.Errorf("Illegal value for References: %v", conf.References)
+	case conf.Degree < 1:
+		err = fmt.Errorf("Illegal value for Degree: %v", conf.Degree)
+	case conf.Iter == 0 && conf.Timeout == 0 && conf.Finishing == nil:
+		err = fmt.Errorf("Iter, Timeout or Finishing is needed to stop kmeans")
+	case conf.MiniBatch < 0:
+		err = fmt.Errorf("Illegal value for MiniBatch: %v", conf.MiniBatch)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	conf.Conf.SetDefaultValues()
+	if conf.MinK == 0 {
+		conf.MinK = 1
+	}
+	if conf.References == 0 {
+		conf.References = 10
+	}
+	if conf.Degree == 0 {
+		conf.Degree = runtime.NumCPU()
+	}
+}
@@ -0,0 +1,44 @@
+package selection_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/selection"
+)
+
+func TestSelection_ConfErrorMaxK(t *testing.T) {
+	var conf = selection.Conf{MinK: 3, MaxK: 2, Conf: kmeans.Conf{CtrlConf: core.CtrlConf{Iter: 10}}}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSelection_ConfErrorCriterion(t *testing.T) {
+	var conf = selection.Conf{MaxK: 2, Criterion: selection.Criterion(12), Conf: kmeans.Conf{CtrlConf: core.CtrlConf{Iter: 10}}}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSelection_ConfErrorIter(t *testing.T) {
+	var conf = selection.Conf{MaxK: 2}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSelection_Conf(t *testing.T) {
+	var conf = selection.Conf{MaxK: 2, Conf: kmeans.Conf{CtrlConf: core.CtrlConf{Iter: 10}}}
+	var err = conf.Verify()
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if conf.MinK != 1 || conf.References != 10 || conf.Degree < 1 {
+		t.Error("default values expected")
+	}
+}
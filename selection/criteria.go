@@ -0,0 +1,88 @@
+package selection
+
+import (
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+// elbow sets the elbow values of the curve: the distance below the line between the extreme losses,
+// K and losses being scaled to [0, 1]
+func elbow(curve Curve) {
+	var first, last = curve[0], curve[len(curve)-1]
+	var dk, dloss = float64(last.K - first.K), first.Loss - last.Loss
+	for i := range curve {
+		curve[i].Value = 0
+		if dk > 0 && dloss > 0 {
+			var x = float64(curve[i].K-first.K) / dk
+			var y = (curve[i].Loss - last.Loss) / dloss
+			curve[i].Value = 1 - x - y
+		}
+	}
+}
+
+// gap returns the gap statistic of a clustering and its standard error.
+// Reference datasets are drawn uniformly in the bounding box of the elements
+// and clustered with Iter kmeans iterations, or referenceIter if Iter is 0.
+func gap(conf Conf, kConf *kmeans.Conf, dataLoss float64, space core.Space, data []core.Elemt, initializer core.Initializer) (value float64, stdErr float64, err error) {
+	var lower, upper = bounds(data)
+	var iter = kConf.Iter
+	if iter == 0 {
+		iter = referenceIter
+	}
+	var logs = make([]float64, conf.References)
+	for b := range logs {
+		var reference = make([]core.Elemt, len(data))
+		for i := range reference {
+			var vector = make([]float64, len(lower))
+			for j := range vector {
+				vector[j] = lower[j] + kConf.RGen.Float64()*(upper[j]-lower[j])
+			}
+			reference[i] = vector
+		}
+		var centroids core.Clust
+		if centroids, err = initializer(kConf.K, reference, space, kConf.RGen); err != nil {
+			return
+		}
+		var strategy = kmeans.SeqStrategy{}
+		for i := 0; i < iter; i++ {
+			centroids = strategy.Iterate(space, centroids, reference, nil)
+		}
+		logs[b] = math.Log(loss(centroids, reference, space))
+	}
+	var mean, variance = meanVariance(logs)
+	value = mean - math.Log(dataLoss)
+	stdErr = math.Sqrt(variance) * math.Sqrt(1+1/float64(conf.References))
+	return
+}
+
+// referenceIter is the number of kmeans iterations over reference datasets when Iter is 0
+const referenceIter = 100
+
+// bounds returns the lower and upper bounds of vectors in each dimension
+func bounds(data []core.Elemt) (lower []float64, upper []float64) {
+	var first = data[0].([]float64)
+	lower, upper = make([]float64, len(first)), make([]float64, len(first))
+	copy(lower, first)
+	copy(upper, first)
+	for _, elemt := range data[1:] {
+		for j, x := range elemt.([]float64) {
+			lower[j], upper[j] = math.Min(lower[j], x), math.Max(upper[j], x)
+		}
+	}
+	return
+}
+
+// meanVariance returns the mean and the variance of values
+func meanVariance(values []float64) (mean float64, variance float64) {
+	for _, x := range values {
+		mean += x
+	}
+	mean /= float64(len(values))
+	for _, x := range values {
+		variance += (x - mean) * (x - mean)
+	}
+	variance /= float64(len(values))
+	return
+}
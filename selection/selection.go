@@ -0,0 +1,179 @@
+// Package selection chooses the number of kmeans clusters.
+// A kmeans algorithm is run for each K of a range and the clusterings are scored with a criterion.
+package selection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/metrics"
+
+	"golang.org/x/exp/rand"
+)
+
+// Criterion scores clusterings for the selection of K
+type Criterion int
+
+const (
+	// Elbow selects the K whose loss is the farthest below the line between the losses of extreme K
+	Elbow Criterion = iota
+	// BIC selects the K that maximizes the bayesian information criterion of spherical gaussian clusters
+	BIC
+	// Gap selects the smallest K whose gap statistic is within one standard error of the gap of K+1
+	Gap
+	// Silhouette selects the K that maximizes the mean silhouette coefficient
+	Silhouette
+)
+
+// String returns the criterion name
+func (criterion Criterion) String() string {
+	switch criterion {
+	case Elbow:
+		return "elbow"
+	case BIC:
+		return "bic"
+	case Gap:
+		return "gap"
+	case Silhouette:
+		return "silhouette"
+	}
+	return fmt.Sprintf("Criterion(%d)", int(criterion))
+}
+
+// ErrVectors raised when a criterion needs vectors of float64 and elements are not
+var ErrVectors = errors.New("elements must be []float64 vectors")
+
+// norm of the losses
+const norm = 2.
+
+// Score of the clustering with K centroids
+type Score struct {
+	K     int
+	Loss  float64 // sum of squared distances between elements and their nearest centroid
+	Value float64 // value of the criterion, NaN if it is not defined
+	Error float64 // standard error of the gap statistic
+}
+
+// Curve of scores by increasing K
+type Curve []Score
+
+// Select runs a kmeans algorithm for each K of the configured range, scores each clustering with the criterion
+// and returns the best algorithm with the score curve.
+// Algorithms are copies of the same kmeans algorithm and run in parallel.
+func Select(conf Conf, space core.Space, data []core.Elemt, initializer core.Initializer) (best *core.Algo, curve Curve, err error) {
+	if err = conf.Verify(); err != nil {
+		return
+	}
+	if len(data) == 0 {
+		err = errors.New("at least one element is needed")
+		return
+	}
+	if conf.Criterion == BIC || conf.Criterion == Gap {
+		if err = checkVectors(data); err != nil {
+			return
+		}
+	}
+
+	var size = conf.MaxK - conf.MinK + 1
+	var confs = make([]kmeans.Conf, size)
+	for i := range confs {
+		confs[i] = conf.Conf
+		confs[i].K = conf.MinK + i
+		confs[i].RGen = rand.New(rand.NewSource(conf.RGen.Uint64()))
+	}
+	var degree = conf.Degree
+	if conf.MiniBatch > 0 {
+		degree = 1 // copies share the random generator of mini-batch samples
+	}
+	if degree > size {
+		degree = size
+	}
+
+	var template = kmeans.NewAlgo(confs[0], space, data, initializer)
+	var algos = make([]*core.Algo, size)
+	var errs = make([]error, size)
+	curve = make(Curve, size)
+	var process = func(start int, end int, _ int) {
+		for i := start; i < end; i++ {
+			algos[i], curve[i], errs[i] = fit(conf, &confs[i], template, space, data, initializer)
+		}
+	}
+	core.Par(process, size, degree)
+	for _, err = range errs {
+		if err != nil {
+			return
+		}
+	}
+
+	if conf.Criterion == Elbow {
+		elbow(curve)
+	}
+	best = algos[choose(conf.Criterion, curve)]
+	return
+}
+
+// fit runs a copy of the template algorithm and scores its clustering
+func fit(conf Conf, kConf *kmeans.Conf, template *core.Algo, space core.Space, data []core.Elemt, initializer core.Initializer) (algo *core.Algo, score Score, err error) {
+	var copied core.OnlineClust
+	if copied, err = template.Copy(kConf, space); err != nil {
+		return
+	}
+	algo = copied.(*core.Algo)
+	if err = algo.Batch(); err != nil {
+		return
+	}
+	var centroids = algo.Centroids()
+	score.K = kConf.K
+	score.Loss = loss(centroids, data, space)
+	switch conf.Criterion {
+	case BIC:
+		score.Value = kmeans.BIC(centroids, data, nil, space)
+	case Gap:
+		score.Value, score.Error, err = gap(conf, kConf, score.Loss, space, data, initializer)
+	case Silhouette:
+		if score.Value, err = metrics.Silhouette(data, centroids, space); err == metrics.ErrClusters {
+			score.Value, err = math.NaN(), nil
+		}
+	}
+	return
+}
+
+// choose returns the index of the best score of the curve
+func choose(criterion Criterion, curve Curve) (best int) {
+	if criterion == Gap {
+		for i := 0; i < len(curve)-1; i++ {
+			if curve[i].Value >= curve[i+1].Value-curve[i+1].Error {
+				return i
+			}
+		}
+	}
+	for i, score := range curve {
+		if math.IsNaN(score.Value) {
+			continue
+		}
+		if math.IsNaN(curve[best].Value) || score.Value > curve[best].Value {
+			best = i
+		}
+	}
+	return
+}
+
+// loss returns the sum of squared distances between elements and their nearest centroid
+func loss(centroids core.Clust, data []core.Elemt, space core.Space) (result float64) {
+	for _, stats := range centroids.ReduceStats(data, nil, space, norm) {
+		result += stats.Loss
+	}
+	return
+}
+
+func checkVectors(data []core.Elemt) error {
+	for _, elemt := range data {
+		if _, ok := elemt.([]float64); !ok {
+			return ErrVectors
+		}
+	}
+	return nil
+}
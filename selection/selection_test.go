@@ -0,0 +1,99 @@
+package selection_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/selection"
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+// blobs returns 3 gaussian blobs of 30 vectors
+func blobs() (data []core.Elemt) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var centers = [][]float64{{0, 0}, {10, 0}, {5, 9}}
+	for _, center := range centers {
+		for i := 0; i < 30; i++ {
+			data = append(data, []float64{center[0] + rgen.NormFloat64(), center[1] + rgen.NormFloat64()})
+		}
+	}
+	return
+}
+
+func newConf(criterion selection.Criterion) selection.Conf {
+	return selection.Conf{
+		MinK:      1,
+		MaxK:      6,
+		Criterion: criterion,
+		Conf: kmeans.Conf{
+			RGen:     rand.New(rand.NewSource(6305689164243)),
+			CtrlConf: core.CtrlConf{Iter: 20},
+		},
+	}
+}
+
+func TestSelect(t *testing.T) {
+	for _, criterion := range []selection.Criterion{selection.Elbow, selection.BIC, selection.Gap, selection.Silhouette} {
+		var best, curve, err = selection.Select(newConf(criterion), space, blobs(), kmeans.PPInitializer)
+		test.AssertNoError(t, err)
+
+		test.AssertEqual(t, 6, len(curve))
+		for i, score := range curve {
+			test.AssertEqual(t, i+1, score.K)
+		}
+		test.AssertTrue(t, curve[2].Loss < curve[1].Loss)
+		test.AssertEqual(t, 3, len(best.Centroids()))
+		if t.Failed() {
+			t.Log(criterion, curve)
+			return
+		}
+	}
+}
+
+func TestSelect_Silhouette(t *testing.T) {
+	var _, curve, err = selection.Select(newConf(selection.Silhouette), space, blobs(), kmeans.PPInitializer)
+	test.AssertNoError(t, err)
+	test.AssertTrue(t, curve[0].Value != curve[0].Value) // not defined for one cluster
+}
+
+func TestSelect_Gap(t *testing.T) {
+	var _, curve, err = selection.Select(newConf(selection.Gap), space, blobs(), kmeans.PPInitializer)
+	test.AssertNoError(t, err)
+	for _, score := range curve {
+		test.AssertTrue(t, score.Error > 0)
+	}
+}
+
+func TestSelect_Vectors(t *testing.T) {
+	var data = []core.Elemt{"a", "b"}
+	var _, _, err = selection.Select(newConf(selection.BIC), space, data, kmeans.PPInitializer)
+	test.AssertEqual(t, selection.ErrVectors, err)
+}
+
+func TestSelect_Empty(t *testing.T) {
+	var _, _, err = selection.Select(newConf(selection.Elbow), space, nil, kmeans.PPInitializer)
+	test.AssertError(t, err)
+}
+
+func TestCriterion_String(t *testing.T) {
+	test.AssertEqual(t, "gap", selection.Gap.String())
+	test.AssertEqual(t, "Criterion(12)", selection.Criterion(12).String())
+}
+
+func TestSelect_Degree(t *testing.T) {
+	var seq = newConf(selection.Elbow)
+	seq.Degree = 1
+	var _, expected, _ = selection.Select(seq, space, blobs(), kmeans.PPInitializer)
+	var par = newConf(selection.Elbow)
+	par.Degree = 3
+	var _, actual, _ = selection.Select(par, space, blobs(), kmeans.PPInitializer)
+
+	for i := range expected {
+		test.AssertAlmostEqual(t, expected[i].Loss, actual[i].Loss)
+	}
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// size of the status queue of each subscriber
+const queueSize = 16
+
+// Events broadcasts algorithm status notifications to subscribers.
+// Its Notify method must be set as the core.CtrlConf.StatusNotifier of the algorithm.
+type Events struct {
+	mutex       sync.Mutex
+	subscribers map[chan core.OCStatus]bool
+}
+
+// NewEvents creates a new Events instance
+func NewEvents() *Events {
+	return &Events{
+		subscribers: map[chan core.OCStatus]bool{},
+	}
+}
+
+// Notify sends a status to all subscribers.
+// Slow subscribers miss notifications rather than blocking the algorithm.
+func (events *Events) Notify(_ core.OnlineClust, status core.OCStatus) {
+	events.mutex.Lock()
+	defer events.mutex.Unlock()
+	for subscriber := range events.subscribers {
+		select {
+		case subscriber <- status:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel that receives status notifications
+func (events *Events) Subscribe() chan core.OCStatus {
+	events.mutex.Lock()
+	defer events.mutex.Unlock()
+	var subscriber = make(chan core.OCStatus, queueSize)
+	events.subscribers[subscriber] = true
+	return subscriber
+}
+
+// Unsubscribe stops sending notifications to the given channel
+func (events *Events) Unsubscribe(subscriber chan core.OCStatus) {
+	events.mutex.Lock()
+	defer events.mutex.Unlock()
+	delete(events.subscribers, subscriber)
+}
+
+// serve streams status notifications as server sent events until the client disconnects
+func (events *Events) serve(w http.ResponseWriter, r *http.Request) {
+	var flusher, ok = w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming not supported"))
+		return
+	}
+	var subscriber = events.Subscribe()
+	defer events.Unsubscribe(subscriber)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case status := <-subscriber:
+			var data, _ = json.Marshal(NewStatus(status))
+			fmt.Fprintf(w, "event: status\ndata: %s\n\n", data)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
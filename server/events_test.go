@@ -0,0 +1,60 @@
+package server_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/server"
+)
+
+func TestEvents_Notify(t *testing.T) {
+	var events = server.NewEvents()
+	var subscriber = events.Subscribe()
+
+	events.Notify(nil, core.NewOCStatus(core.Running))
+	if status := <-subscriber; status.Value != core.Running {
+		t.Error("Expected running status got", status)
+	}
+
+	events.Unsubscribe(subscriber)
+	events.Notify(nil, core.NewOCStatus(core.Idle))
+	select {
+	case status := <-subscriber:
+		t.Error("Expected no status got", status)
+	default:
+	}
+}
+
+func TestEvents_Stream(t *testing.T) {
+	var events = server.NewEvents()
+	var srv, _ = newServer(events)
+	var ts = httptest.NewServer(srv)
+	defer ts.Close()
+
+	var resp, err = http.Get(ts.URL + "/events")
+	if err != nil {
+		t.Fatal("no error expected", err)
+	}
+	defer resp.Body.Close()
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Error("Expected event stream got", contentType)
+	}
+
+	request(srv, http.MethodPost, "/push", vectors)
+	request(srv, http.MethodPost, "/play", "")
+
+	var reader = bufio.NewReader(resp.Body)
+	var line, _ = reader.ReadString('\n')
+	if line != "event: status\n" {
+		t.Error("Expected status event got", line)
+	}
+	line, _ = reader.ReadString('\n')
+	if !strings.HasPrefix(line, "data: {\"value\":") {
+		t.Error("Expected status data got", line)
+	}
+	request(srv, http.MethodPost, "/stop", "")
+}
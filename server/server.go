@@ -0,0 +1,221 @@
+// Package server exposes an online clustering algorithm over HTTP with JSON payloads.
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Server handles HTTP requests for an online clustering algorithm.
+// Elements are JSON encoded with the given codec.
+type Server struct {
+	oc     core.OnlineClust
+	codec  core.Codec
+	events *Events
+	mux    *http.ServeMux
+}
+
+// Prediction is the response element of a prediction
+type Prediction struct {
+	Centroid json.RawMessage `json:"centroid"`
+	Label    int             `json:"label"`
+	Distance float64         `json:"distance"`
+}
+
+// Status is the response of status requests
+type Status struct {
+	Value string `json:"value"`
+	Error string `json:"error,omitempty"`
+}
+
+// NewServer creates a server for the given algorithm.
+// The codec must encode elements in JSON, e.g. one returned by core.GetCodec(space, core.JSON).
+// If events is not nil, status notifications are streamed on /events.
+func NewServer(oc core.OnlineClust, codec core.Codec, events *Events) *Server {
+	var server = &Server{
+		oc:     oc,
+		codec:  codec,
+		events: events,
+		mux:    http.NewServeMux(),
+	}
+	server.mux.HandleFunc("/push", post(server.push))
+	server.mux.HandleFunc("/predict", post(server.predict))
+	server.mux.HandleFunc("/centroids", get(server.centroids))
+	server.mux.HandleFunc("/status", get(server.status))
+	server.mux.HandleFunc("/figures", get(server.figures))
+	server.mux.HandleFunc("/stats", get(server.stats))
+	server.mux.HandleFunc("/play", post(server.control(oc.Play)))
+	server.mux.HandleFunc("/pause", post(server.control(oc.Pause)))
+	server.mux.HandleFunc("/stop", post(server.control(oc.Stop)))
+	server.mux.HandleFunc("/batch", post(server.control(oc.Batch)))
+	if events != nil {
+		server.mux.HandleFunc("/events", get(events.serve))
+	}
+	return server
+}
+
+// ServeHTTP dispatches requests to the algorithm
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mux.ServeHTTP(w, r)
+}
+
+func post(handler http.HandlerFunc) http.HandlerFunc {
+	return allow(http.MethodPost, handler)
+}
+
+func get(handler http.HandlerFunc) http.HandlerFunc {
+	return allow(http.MethodGet, handler)
+}
+
+func allow(method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %v not allowed", r.Method))
+		} else {
+			handler(w, r)
+		}
+	}
+}
+
+// push decodes a JSON array of elements and pushes them in the algorithm
+func (server *Server) push(w http.ResponseWriter, r *http.Request) {
+	var elemts, err = server.readElemts(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var pushed int
+	for pushed < len(elemts) && err == nil {
+		err = server.oc.Push(elemts[pushed])
+		if err == nil {
+			pushed++
+		}
+	}
+	if err != nil {
+		// elements before the failing one are pushed
+		write(w, http.StatusConflict, map[string]interface{}{"error": err.Error(), "pushed": pushed})
+		return
+	}
+	writeJSON(w, map[string]int{"pushed": pushed})
+}
+
+// predict decodes a JSON array of elements and returns their predictions
+func (server *Server) predict(w http.ResponseWriter, r *http.Request) {
+	var elemts, err = server.readElemts(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var predictions = make([]Prediction, len(elemts))
+	for i := 0; i < len(elemts) && err == nil; i++ {
+		var centroid, label, dist = server.oc.Predict(elemts[i])
+		if centroid == nil {
+			err = core.ErrNotAlive
+		} else {
+			predictions[i].Label = label
+			predictions[i].Distance = dist
+			predictions[i].Centroid, err = server.codec.Encode(centroid)
+		}
+	}
+	if err != nil {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	writeJSON(w, predictions)
+}
+
+// centroids returns the current centroids
+func (server *Server) centroids(w http.ResponseWriter, r *http.Request) {
+	var data, err = core.EncodeAll(server.codec, server.oc.Centroids())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	var centroids = make([]json.RawMessage, len(data))
+	for i := range data {
+		centroids[i] = data[i]
+	}
+	writeJSON(w, centroids)
+}
+
+// status returns the algorithm status
+func (server *Server) status(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, NewStatus(server.oc.Status()))
+}
+
+// figures returns the runtime figures
+func (server *Server) figures(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, server.oc.RuntimeFigures())
+}
+
+// stats returns the cluster statistics, an empty array if the algorithm does not maintain them
+func (server *Server) stats(w http.ResponseWriter, r *http.Request) {
+	var stats = server.oc.Stats()
+	if stats == nil {
+		stats = []core.ClusterStats{}
+	}
+	writeJSON(w, stats)
+}
+
+// control returns a handler that calls the given controller method and returns the algorithm status
+func (server *Server) control(method func() error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var err = method()
+		if err != nil {
+			writeError(w, http.StatusConflict, err)
+		} else {
+			writeJSON(w, NewStatus(server.oc.Status()))
+		}
+	}
+}
+
+func (server *Server) readElemts(r *http.Request) (elemts []core.Elemt, err error) {
+	var body []byte
+	body, err = ioutil.ReadAll(r.Body)
+	var raws []json.RawMessage
+	if err == nil {
+		err = json.Unmarshal(body, &raws)
+	}
+	if err == nil {
+		var data = make([][]byte, len(raws))
+		for i := range raws {
+			data[i] = raws[i]
+		}
+		elemts, err = core.DecodeAll(server.codec, data)
+	}
+	return
+}
+
+// NewStatus converts an algorithm status to its response
+func NewStatus(status core.OCStatus) Status {
+	var result = Status{Value: status.Value.String()}
+	if status.Error != nil {
+		result.Error = status.Error.Error()
+	}
+	return result
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	write(w, http.StatusOK, value)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	write(w, code, map[string]string{"error": err.Error()})
+}
+
+// write encodes value before writing the code so that encoding errors are answered with an internal error
+func write(w http.ResponseWriter, code int, value interface{}) {
+	var body, err = json.Marshal(value)
+	if err != nil {
+		code = http.StatusInternalServerError
+		body, _ = json.Marshal(map[string]string{"error": err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(append(body, '\n'))
+}
@@ -0,0 +1,163 @@
+package server_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/server"
+)
+
+const vectors = `[[7.2, 6, 8, 11, 10], [-8, -10.5, -7, -8.5, -9], [42, 41.2, 42, 40.2, 45],
+	[9, 8, 7, 7.5, 10], [7.2, 6, 8, 11, 10], [-9, -10, -8, -8, -7.5],
+	[42, 41.2, 42.2, 40.2, 45], [50, 51.2, 49, 40, 45.2]]`
+
+func newServer(events *server.Events) (*server.Server, *core.Algo) {
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}}
+	if events != nil {
+		conf.StatusNotifier = events.Notify
+	}
+	var algo = kmeans.NewAlgo(conf, euclid.NewSpace(), nil, kmeans.GivenInitializer)
+	var codec, _ = core.GetCodec(algo.Space(), core.JSON)
+	return server.NewServer(algo, codec, events), algo
+}
+
+func request(handler http.Handler, method string, path string, body string) *httptest.ResponseRecorder {
+	var recorder = httptest.NewRecorder()
+	var req = httptest.NewRequest(method, path, strings.NewReader(body))
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func decode(t *testing.T, recorder *httptest.ResponseRecorder, value interface{}) {
+	if err := json.Unmarshal(recorder.Body.Bytes(), value); err != nil {
+		t.Error("no error expected", err, recorder.Body.String())
+	}
+}
+
+func TestServer_Workflow(t *testing.T) {
+	var srv, algo = newServer(nil)
+
+	var rec = request(srv, http.MethodPost, "/push", vectors)
+	var pushed map[string]int
+	decode(t, rec, &pushed)
+	if pushed["pushed"] != 8 {
+		t.Error("Expected 8 pushed elements got", pushed)
+	}
+
+	rec = request(srv, http.MethodPost, "/batch", "")
+	var status server.Status
+	decode(t, rec, &status)
+	if rec.Code != http.StatusOK || status.Value != core.Finished.String() {
+		t.Error("Expected finished status got", rec.Code, status)
+	}
+
+	rec = request(srv, http.MethodGet, "/centroids", "")
+	var centroids [][]float64
+	decode(t, rec, &centroids)
+	var expected = algo.Centroids()
+	if len(centroids) != len(expected) {
+		t.Error("Expected", len(expected), "centroids got", len(centroids))
+	}
+
+	rec = request(srv, http.MethodPost, "/predict", `[[-9, -10, -8.3, -8, -7.5]]`)
+	var predictions []server.Prediction
+	decode(t, rec, &predictions)
+	var _, label, _ = algo.Predict(test.Vectors[1])
+	if len(predictions) != 1 || predictions[0].Label != label {
+		t.Error("Expected label", label, "got", predictions)
+	}
+
+	rec = request(srv, http.MethodGet, "/figures", "")
+	var figures core.RuntimeFigures
+	decode(t, rec, &figures)
+	if figures[core.Iterations] != 10 {
+		t.Error("Expected 10 iterations got", figures)
+	}
+
+	rec = request(srv, http.MethodGet, "/stats", "")
+	var stats []core.ClusterStats
+	decode(t, rec, &stats)
+	var size int
+	for i := range stats {
+		size += stats[i].Size
+	}
+	if len(stats) != 3 || size != 8 {
+		t.Error("Expected 3 clusters of 8 elements got", stats)
+	}
+
+	rec = request(srv, http.MethodGet, "/status", "")
+	decode(t, rec, &status)
+	if status.Value != core.Finished.String() {
+		t.Error("Expected finished status got", status)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	var srv, _ = newServer(nil)
+
+	if rec := request(srv, http.MethodGet, "/push", ""); rec.Code != http.StatusMethodNotAllowed {
+		t.Error("Expected method not allowed got", rec.Code)
+	}
+	if rec := request(srv, http.MethodPost, "/push", "[1, 2]"); rec.Code != http.StatusBadRequest {
+		t.Error("Expected bad request got", rec.Code)
+	}
+	if rec := request(srv, http.MethodPost, "/predict", "[[1, 2]]"); rec.Code != http.StatusConflict {
+		t.Error("Expected conflict got", rec.Code)
+	}
+	if rec := request(srv, http.MethodPost, "/pause", ""); rec.Code != http.StatusConflict {
+		t.Error("Expected conflict got", rec.Code)
+	}
+	if rec := request(srv, http.MethodGet, "/events", ""); rec.Code != http.StatusNotFound {
+		t.Error("Expected not found got", rec.Code)
+	}
+}
+
+// failing fails to push elements beyond a limit and returns NaN figures
+type failing struct {
+	*core.Algo
+	limit int
+}
+
+func (oc *failing) Push(elemt core.Elemt) error {
+	if oc.limit == 0 {
+		return errors.New("limit reached")
+	}
+	oc.limit--
+	return oc.Algo.Push(elemt)
+}
+
+func (oc *failing) RuntimeFigures() core.RuntimeFigures {
+	return core.RuntimeFigures{"nan": math.NaN()}
+}
+
+func TestServer_Failing(t *testing.T) {
+	var _, algo = newServer(nil)
+	var codec, _ = core.GetCodec(algo.Space(), core.JSON)
+	var srv = server.NewServer(&failing{algo, 3}, codec, nil)
+
+	var rec = request(srv, http.MethodPost, "/push", vectors)
+	var pushed struct {
+		Error  string
+		Pushed int
+	}
+	decode(t, rec, &pushed)
+	if rec.Code != http.StatusConflict || pushed.Pushed != 3 || pushed.Error == "" {
+		t.Error("Expected conflict after 3 pushed elements got", rec.Code, pushed)
+	}
+
+	rec = request(srv, http.MethodGet, "/figures", "")
+	var failure map[string]string
+	decode(t, rec, &failure)
+	if rec.Code != http.StatusInternalServerError || failure["error"] == "" {
+		t.Error("Expected internal error got", rec.Code, failure)
+	}
+}
@@ -0,0 +1,100 @@
+package sparse
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// BinaryCodec encodes sparse vectors as their size and number of non zero values
+// followed by index increments and little endian float64 values
+type BinaryCodec struct{}
+
+// Encode returns the binary encoding of a sparse vector
+func (BinaryCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	var vector = elemt.(Vector)
+	var buf = appendUvarint(nil, uint64(vector.Size))
+	buf = appendUvarint(buf, uint64(len(vector.Indices)))
+	var previous = 0
+	var value [8]byte
+	for i, index := range vector.Indices {
+		buf = appendUvarint(buf, uint64(index-previous))
+		previous = index
+		binary.LittleEndian.PutUint64(value[:], math.Float64bits(vector.Values[i]))
+		buf = append(buf, value[:]...)
+	}
+	return buf, nil
+}
+
+func appendUvarint(buf []byte, x uint64) []byte {
+	var header [binary.MaxVarintLen64]byte
+	var n = binary.PutUvarint(header[:], x)
+	return append(buf, header[:n]...)
+}
+
+// Decode returns the sparse vector encoded in data
+func (BinaryCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var size, n = binary.Uvarint(data)
+	if n <= 0 || size > math.MaxInt32 {
+		return nil, core.ErrDecode
+	}
+	data = data[n:]
+	var nnz uint64
+	nnz, n = binary.Uvarint(data)
+	if n <= 0 || nnz > uint64(len(data)-n)/9 {
+		return nil, core.ErrDecode
+	}
+	data = data[n:]
+	var vector = Vector{Size: int(size), Indices: make([]int, nnz), Values: make([]float64, nnz)}
+	var index = 0
+	for i := range vector.Indices {
+		var increment uint64
+		increment, n = binary.Uvarint(data)
+		if n <= 0 || len(data)-n < 8 {
+			return nil, core.ErrDecode
+		}
+		index += int(increment)
+		vector.Indices[i] = index
+		vector.Values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[n:]))
+		data = data[n+8:]
+	}
+	if len(data) > 0 || vector.Check() != nil {
+		return nil, core.ErrDecode
+	}
+	return vector, nil
+}
+
+// JSONCodec encodes sparse vectors as JSON objects with size, indices and values
+type JSONCodec struct{}
+
+// Encode returns the JSON encoding of a sparse vector
+func (JSONCodec) Encode(elemt core.Elemt) ([]byte, error) {
+	return json.Marshal(elemt.(Vector))
+}
+
+// Decode returns the sparse vector encoded in data
+func (JSONCodec) Decode(data []byte) (elemt core.Elemt, err error) {
+	var vector Vector
+	err = json.Unmarshal(data, &vector)
+	if err == nil {
+		err = vector.Check()
+	}
+	if err == nil {
+		elemt = vector
+	}
+	return
+}
+
+func codec(format core.Format) (codec core.Codec, err error) {
+	switch format {
+	case core.Binary:
+		codec = BinaryCodec{}
+	case core.JSON:
+		codec = JSONCodec{}
+	default:
+		err = core.ErrFormat
+	}
+	return
+}
@@ -0,0 +1,60 @@
+package sparse_test
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/sparse"
+)
+
+func TestCodec(t *testing.T) {
+	var vector = sparse.FromDense(dense1)
+	for _, space := range []core.Space{sparse.NewSpace(), sparse.NewCosinusSpace()} {
+		for _, format := range []core.Format{core.Binary, core.JSON} {
+			var codec, err = core.GetCodec(space, format)
+			if err != nil {
+				t.Error("no error expected", err)
+			}
+			data, err := codec.Encode(vector)
+			if err != nil {
+				t.Error("no error expected", err)
+			}
+			decoded, err := codec.Decode(data)
+			if err != nil {
+				t.Error("no error expected", err)
+			}
+			if !reflect.DeepEqual(vector, decoded) {
+				t.Error("Expected", vector, "got", decoded)
+			}
+		}
+	}
+}
+
+func TestCodec_Malformed(t *testing.T) {
+	var codec = sparse.BinaryCodec{}
+	var data, _ = codec.Encode(sparse.FromDense(dense1))
+	for _, malformed := range [][]byte{nil, data[:len(data)-1], append(data, 0)} {
+		if _, err := codec.Decode(malformed); err != core.ErrDecode {
+			t.Error("decode error expected", err)
+		}
+	}
+	if _, err := (sparse.JSONCodec{}).Decode([]byte(`{"size": 2, "indices": [3], "values": [1]}`)); err != sparse.ErrIndex {
+		t.Error("index error expected", err)
+	}
+	if _, err := (sparse.JSONCodec{}).Decode([]byte(`{"size": -1, "indices": [], "values": []}`)); err != sparse.ErrSize {
+		t.Error("size error expected", err)
+	}
+	for _, size := range []uint64{math.MaxInt32 + 1, math.MaxUint64} {
+		var huge = make([]byte, binary.MaxVarintLen64+1)
+		var n = binary.PutUvarint(huge, size)
+		if _, err := codec.Decode(huge[:n+1]); err != core.ErrDecode {
+			t.Error("decode error expected", err)
+		}
+	}
+	if _, err := sparse.NewSpace().Codec(core.Format(-1)); err != core.ErrFormat {
+		t.Error("format error expected", err)
+	}
+}
@@ -0,0 +1,87 @@
+package sparse
+
+import (
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Space for sparse vectors (Vector) with Euclid distance
+type Space struct{}
+
+// NewSpace creates a new Space
+func NewSpace() Space {
+	return Space{}
+}
+
+// Dist computes the Euclid distance between two sparse vectors
+func (space Space) Dist(elemt1, elemt2 core.Elemt) float64 {
+	return Dist(elemt1.(Vector), elemt2.(Vector))
+}
+
+// IsMetric returns true since the Euclid distance satisfies the triangle inequality
+func (space Space) IsMetric() bool {
+	return true
+}
+
+// Combine returns the weighted average of two sparse vectors
+func (space Space) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return Combine(elemt1.(Vector), weight1, elemt2.(Vector), weight2)
+}
+
+// Copy creates a copy of a sparse vector
+func (space Space) Copy(elemt core.Elemt) core.Elemt {
+	return elemt.(Vector).Copy()
+}
+
+// Dim returns the greatest size of the given sparse vectors
+func (space Space) Dim(data []core.Elemt) (dim int) {
+	for _, elemt := range data {
+		if size := elemt.(Vector).Size; size > dim {
+			dim = size
+		}
+	}
+	return
+}
+
+// Codec returns the sparse vector codec for the given format
+func (space Space) Codec(format core.Format) (core.Codec, error) {
+	return codec(format)
+}
+
+// CosinusSpace for sparse vectors (Vector) with cosinus distance
+type CosinusSpace struct {
+	vspace Space
+}
+
+// NewCosinusSpace creates a new CosinusSpace
+func NewCosinusSpace() CosinusSpace {
+	return CosinusSpace{
+		vspace: NewSpace(),
+	}
+}
+
+// Dist returns the cosinus distance between two sparse vectors
+func (space CosinusSpace) Dist(elemt1, elemt2 core.Elemt) float64 {
+	var v1 = elemt1.(Vector)
+	var v2 = elemt2.(Vector)
+	return 1 - Dot(v1, v2)/Norm(v1)/Norm(v2)
+}
+
+// Combine returns the weighted average of two sparse vectors
+func (space CosinusSpace) Combine(elemt1 core.Elemt, weight1 int, elemt2 core.Elemt, weight2 int) core.Elemt {
+	return space.vspace.Combine(elemt1, weight1, elemt2, weight2)
+}
+
+// Copy creates a copy of a sparse vector
+func (space CosinusSpace) Copy(elemt core.Elemt) core.Elemt {
+	return space.vspace.Copy(elemt)
+}
+
+// Dim returns the greatest size of the given sparse vectors
+func (space CosinusSpace) Dim(data []core.Elemt) int {
+	return space.vspace.Dim(data)
+}
+
+// Codec returns the sparse vector codec for the given format
+func (space CosinusSpace) Codec(format core.Format) (core.Codec, error) {
+	return codec(format)
+}
@@ -0,0 +1,65 @@
+package sparse_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/cosinus"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/mcmc"
+	"github.com/wearelumenai/distclus/sparse"
+
+	"golang.org/x/exp/rand"
+)
+
+func documents() (data []core.Elemt) {
+	for i := 0; i < 30; i++ {
+		var offset = (i % 3) * 1000
+		var v, _ = sparse.NewVector(100000, []int{offset + i%7, offset + 10, offset + 20}, []float64{1, 2, 3})
+		data = append(data, v)
+	}
+	return
+}
+
+func assertTopics(t *testing.T, algo *core.Algo, data []core.Elemt) {
+	var _, first, _ = algo.Predict(data[0])
+	for i := range data {
+		var _, label, _ = algo.Predict(data[i])
+		if (i%3 == 0) != (label == first) {
+			t.Error("Expected documents of the same topic in the same cluster")
+		}
+	}
+}
+
+func TestSpace(t *testing.T) {
+	var space = sparse.NewSpace()
+	var v1, v2 = sparse.FromDense(dense1), sparse.FromDense(dense2)
+	test.AssertAlmostEqual(t, sparse.Dist(v1, v2), space.Dist(v1, v2))
+	test.AssertTrue(t, core.IsMetric(space))
+	test.AssertArrayAlmostEqual(t, sparse.Combine(v1, 2, v2, 1).Dense(), space.Combine(v1, 2, v2, 1).(sparse.Vector).Dense())
+	test.AssertEqual(t, 7, space.Dim([]core.Elemt{v1, sparse.FromDense(dense1[:3])}))
+}
+
+func TestCosinusSpace(t *testing.T) {
+	var space = sparse.NewCosinusSpace()
+	var v1, v2 = sparse.FromDense(dense1), sparse.FromDense(dense2)
+	test.AssertAlmostEqual(t, cosinus.NewSpace().Dist(dense1, dense2), space.Dist(v1, v2))
+	test.AssertFalse(t, core.IsMetric(space))
+}
+
+func TestSpace_KMeans(t *testing.T) {
+	var data = documents()
+	var conf = kmeans.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 10}, RGen: rand.New(rand.NewSource(6305689164243))}
+	var algo = kmeans.NewAlgo(conf, sparse.NewSpace(), data, kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+	assertTopics(t, algo, data)
+}
+
+func TestCosinusSpace_MCMC(t *testing.T) {
+	var data = documents()
+	var conf = mcmc.Conf{InitK: 3, MaxK: 5, B: .5, Amp: .01, CtrlConf: core.CtrlConf{Iter: 20}, RGen: rand.New(rand.NewSource(6305689164243))}
+	var algo = mcmc.NewAlgo(conf, sparse.NewCosinusSpace(), data, kmeans.PPInitializer, mcmc.NewDirac())
+	test.AssertNoError(t, algo.Batch())
+	assertTopics(t, algo, data)
+}
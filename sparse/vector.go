@@ -0,0 +1,176 @@
+// Package sparse allows to computes clusters of sparse vectors with Euclid or cosinus distance.
+package sparse
+
+import (
+	"encoding/gob"
+	"errors"
+	"math"
+	"sort"
+)
+
+func init() {
+	gob.Register(Vector{})
+}
+
+// ErrIndex raised when indices of a sparse vector are out of range or not strictly increasing
+var ErrIndex = errors.New("sparse vector indices must be strictly increasing and lower than its size")
+
+// ErrSize raised when the size of a sparse vector is negative or greater than math.MaxInt32
+var ErrSize = errors.New("sparse vector size must be between 0 and math.MaxInt32")
+
+// Vector is a sparse vector of the given size.
+// Indices of non zero values are strictly increasing.
+type Vector struct {
+	Size    int       `json:"size"`
+	Indices []int     `json:"indices"`
+	Values  []float64 `json:"values"`
+}
+
+// NewVector creates a sparse vector given its size, indices and values of its non zero values.
+// Indices are sorted if needed.
+func NewVector(size int, indices []int, values []float64) (vector Vector, err error) {
+	if len(indices) != len(values) {
+		err = ErrIndex
+		return
+	}
+	vector = Vector{Size: size, Indices: make([]int, len(indices)), Values: make([]float64, len(values))}
+	var order = make([]int, len(indices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return indices[order[a]] < indices[order[b]] })
+	for i, j := range order {
+		vector.Indices[i] = indices[j]
+		vector.Values[i] = values[j]
+	}
+	err = vector.Check()
+	return
+}
+
+// FromDense creates a sparse vector from a dense one
+func FromDense(dense []float64) Vector {
+	var vector = Vector{Size: len(dense)}
+	for i, x := range dense {
+		if x != 0 {
+			vector.Indices = append(vector.Indices, i)
+			vector.Values = append(vector.Values, x)
+		}
+	}
+	return vector
+}
+
+// Check returns an error if the size is out of range or if indices are out of range or not strictly increasing
+func (v Vector) Check() error {
+	if v.Size < 0 || v.Size > math.MaxInt32 {
+		return ErrSize
+	}
+	if len(v.Indices) != len(v.Values) {
+		return ErrIndex
+	}
+	for i, index := range v.Indices {
+		if index < 0 || index >= v.Size || (i > 0 && index <= v.Indices[i-1]) {
+			return ErrIndex
+		}
+	}
+	return nil
+}
+
+// Dense returns the dense representation of the vector
+func (v Vector) Dense() []float64 {
+	var dense = make([]float64, v.Size)
+	for i, index := range v.Indices {
+		dense[index] = v.Values[i]
+	}
+	return dense
+}
+
+// Copy returns a deep copy of the vector
+func (v Vector) Copy() Vector {
+	var copied = Vector{Size: v.Size, Indices: make([]int, len(v.Indices)), Values: make([]float64, len(v.Values))}
+	copy(copied.Indices, v.Indices)
+	copy(copied.Values, v.Values)
+	return copied
+}
+
+// Dot returns the scalar product of two sparse vectors
+func Dot(v1, v2 Vector) (product float64) {
+	for i, j := 0, 0; i < len(v1.Indices) && j < len(v2.Indices); {
+		switch {
+		case v1.Indices[i] < v2.Indices[j]:
+			i++
+		case v1.Indices[i] > v2.Indices[j]:
+			j++
+		default:
+			product += v1.Values[i] * v2.Values[j]
+			i++
+			j++
+		}
+	}
+	return
+}
+
+// Norm returns the Euclid norm of a sparse vector
+func Norm(v Vector) float64 {
+	return math.Sqrt(Dot(v, v))
+}
+
+// Combine returns the weighted average of two sparse vectors
+func Combine(v1 Vector, weight1 int, v2 Vector, weight2 int) Vector {
+	var w1 = float64(weight1)
+	var w2 = float64(weight2)
+	var t = w1 + w2
+	var size = v1.Size
+	if v2.Size > size {
+		size = v2.Size
+	}
+	var result = Vector{
+		Size:    size,
+		Indices: make([]int, 0, len(v1.Indices)+len(v2.Indices)),
+		Values:  make([]float64, 0, len(v1.Indices)+len(v2.Indices)),
+	}
+	var i, j = 0, 0
+	for i < len(v1.Indices) || j < len(v2.Indices) {
+		var index int
+		var value float64
+		switch {
+		case j == len(v2.Indices) || (i < len(v1.Indices) && v1.Indices[i] < v2.Indices[j]):
+			index, value = v1.Indices[i], v1.Values[i]*w1/t
+			i++
+		case i == len(v1.Indices) || v1.Indices[i] > v2.Indices[j]:
+			index, value = v2.Indices[j], v2.Values[j]*w2/t
+			j++
+		default:
+			index, value = v1.Indices[i], (v1.Values[i]*w1+v2.Values[j]*w2)/t
+			i++
+			j++
+		}
+		if value != 0 {
+			result.Indices = append(result.Indices, index)
+			result.Values = append(result.Values, value)
+		}
+	}
+	return result
+}
+
+// Dist returns the Euclid distance between two sparse vectors
+func Dist(v1, v2 Vector) float64 {
+	var sum = 0.
+	var i, j = 0, 0
+	for i < len(v1.Indices) || j < len(v2.Indices) {
+		var d float64
+		switch {
+		case j == len(v2.Indices) || (i < len(v1.Indices) && v1.Indices[i] < v2.Indices[j]):
+			d = v1.Values[i]
+			i++
+		case i == len(v1.Indices) || v1.Indices[i] > v2.Indices[j]:
+			d = v2.Values[j]
+			j++
+		default:
+			d = v1.Values[i] - v2.Values[j]
+			i++
+			j++
+		}
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,59 @@
+package sparse_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/cosinus"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/sparse"
+)
+
+var dense1 = []float64{0, 1.5, 0, 0, -2, 0, 3}
+var dense2 = []float64{4, 0, 0, 0, 1, 0, 3}
+
+func TestNewVector(t *testing.T) {
+	var vector, err = sparse.NewVector(7, []int{6, 1, 4}, []float64{3, 1.5, -2})
+	test.AssertNoError(t, err)
+	test.AssertArrayAlmostEqual(t, dense1, vector.Dense())
+
+	for _, indices := range [][]int{{1, 1}, {1, 7}, {-1, 2}, {1}} {
+		if _, err = sparse.NewVector(7, indices, []float64{1, 2}); err != sparse.ErrIndex {
+			t.Error("Expected index error got", err)
+		}
+	}
+}
+
+func TestFromDense(t *testing.T) {
+	var vector = sparse.FromDense(dense1)
+	test.AssertArrayEqual(t, []int{1, 4, 6}, vector.Indices)
+	test.AssertArrayAlmostEqual(t, dense1, vector.Dense())
+}
+
+func TestDist(t *testing.T) {
+	var v1, v2 = sparse.FromDense(dense1), sparse.FromDense(dense2)
+	test.AssertAlmostEqual(t, euclid.NewSpace().Dist(dense1, dense2), sparse.Dist(v1, v2))
+	test.AssertAlmostEqual(t, cosinus.ScalarProduct(dense1, dense2), sparse.Dot(v1, v2))
+	test.AssertAlmostEqual(t, cosinus.Norm(dense1), sparse.Norm(v1))
+	test.AssertAlmostEqual(t, 0., sparse.Dist(v1, v1))
+}
+
+func TestCombine(t *testing.T) {
+	var v1, v2 = sparse.FromDense(dense1), sparse.FromDense(dense2)
+	var expected = euclid.NewSpace().PointCombine(dense1, 1, dense2, 3)
+	var combined = sparse.Combine(v1, 1, v2, 3)
+	test.AssertArrayAlmostEqual(t, expected, combined.Dense())
+	test.AssertNoError(t, combined.Check())
+
+	combined = sparse.Combine(sparse.FromDense([]float64{1, 1}), 1, sparse.FromDense([]float64{0, -1}), 1)
+	test.AssertArrayEqual(t, []int{0}, combined.Indices)
+}
+
+func TestVector_Copy(t *testing.T) {
+	var v1 = sparse.FromDense(dense1)
+	var v2 = v1.Copy()
+	v2.Values[0] = 10
+	if v1.Values[0] == v2.Values[0] {
+		t.Error("Expected different vectors")
+	}
+}
@@ -0,0 +1,108 @@
+package spectral
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wearelumenai/distclus/core"
+)
+
+// Affinity defines how the affinity graph is built from distances
+type Affinity int
+
+const (
+	// RBF affinity is exp(-d²/(2σ²)) between all elements
+	RBF Affinity = iota
+	// KNN affinity is 1 between an element and its nearest neighbors, 0 otherwise.
+	// The graph is made symmetric: elements are linked if one is a neighbor of the other.
+	KNN
+)
+
+// String returns the affinity name
+func (affinity Affinity) String() string {
+	switch affinity {
+	case RBF:
+		return "rbf"
+	case KNN:
+		return "knn"
+	}
+	return fmt.Sprintf("Affinity(%d)", int(affinity))
+}
+
+// distances returns the matrix of distances between elements
+func distances(elemts []core.Elemt, space core.Space) (dists [][]float64) {
+	dists = make([][]float64, len(elemts))
+	for i := range dists {
+		dists[i] = make([]float64, len(elemts))
+	}
+	for i := range elemts {
+		for j := 0; j < i; j++ {
+			var d = space.Dist(elemts[i], elemts[j])
+			dists[i][j], dists[j][i] = d, d
+		}
+	}
+	return
+}
+
+// RBFMatrix returns the RBF affinity matrix of elements.
+// If sigma is 0, it is the median distance between elements.
+func RBFMatrix(elemts []core.Elemt, space core.Space, sigma float64) (affinities [][]float64) {
+	var dists = distances(elemts, space)
+	if sigma == 0 {
+		sigma = median(dists)
+	}
+	affinities = make([][]float64, len(dists))
+	for i := range dists {
+		affinities[i] = make([]float64, len(dists))
+		for j := range dists[i] {
+			if sigma > 0 {
+				affinities[i][j] = math.Exp(-dists[i][j] * dists[i][j] / (2 * sigma * sigma))
+			} else {
+				affinities[i][j] = 1
+			}
+		}
+	}
+	return
+}
+
+// median returns the median distance between distinct elements
+func median(dists [][]float64) float64 {
+	var values []float64
+	for i := range dists {
+		values = append(values, dists[i][:i]...)
+	}
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	return values[len(values)/2]
+}
+
+// KNNMatrix returns the symmetric k nearest neighbors affinity matrix of elements, elements being linked to themselves
+func KNNMatrix(elemts []core.Elemt, space core.Space, neighbors int) (affinities [][]float64) {
+	var dists = distances(elemts, space)
+	affinities = make([][]float64, len(dists))
+	for i := range affinities {
+		affinities[i] = make([]float64, len(dists))
+	}
+	var order = make([]int, len(dists))
+	for i := range dists {
+		for j := range order {
+			order[j] = j
+		}
+		sort.SliceStable(order, func(a, b int) bool { return dists[i][order[a]] < dists[i][order[b]] })
+		affinities[i][i] = 1
+		var count = 0
+		for _, j := range order {
+			if count >= neighbors {
+				break
+			}
+			if j != i {
+				affinities[i][j], affinities[j][i] = 1, 1
+				count++
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,50 @@
+package spectral_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/spectral"
+)
+
+var line = []core.Elemt{[]float64{0}, []float64{1}, []float64{3}, []float64{10}}
+
+func TestRBFMatrix(t *testing.T) {
+	var affinities = spectral.RBFMatrix(line, space, 2)
+
+	test.AssertEqual(t, 4, len(affinities))
+	test.AssertAlmostEqual(t, 1, affinities[0][0])
+	test.AssertAlmostEqual(t, math.Exp(-1./8), affinities[0][1])
+	test.AssertAlmostEqual(t, math.Exp(-9./8), affinities[2][0])
+	test.AssertAlmostEqual(t, affinities[1][3], affinities[3][1])
+}
+
+func TestRBFMatrix_Median(t *testing.T) {
+	// distances are 1, 2, 3, 7, 9, 10
+	var affinities = spectral.RBFMatrix(line, space, 0)
+
+	test.AssertAlmostEqual(t, math.Exp(-1./98), affinities[0][1])
+	test.AssertAlmostEqual(t, math.Exp(-.5), affinities[2][3])
+}
+
+func TestKNNMatrix(t *testing.T) {
+	var affinities = spectral.KNNMatrix(line, space, 1)
+
+	var expected = [][]float64{
+		{1, 1, 0, 0},
+		{1, 1, 1, 0},
+		{0, 1, 1, 1},
+		{0, 0, 1, 1},
+	}
+	for i := range expected {
+		test.AssertArrayAlmostEqual(t, expected[i], affinities[i])
+	}
+}
+
+func TestAffinity_String(t *testing.T) {
+	test.AssertEqual(t, "rbf", spectral.RBF.String())
+	test.AssertEqual(t, "knn", spectral.KNN.String())
+	test.AssertEqual(t, "Affinity(12)", spectral.Affinity(12).String())
+}
@@ -0,0 +1,13 @@
+// Package spectral provides spectral implementation of online clustering.
+// Buffered elements are clustered with kmeans in the embedding given by the eigenvectors of
+// their normalized affinity graph, clusters being represented by their average in the original space.
+package spectral
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new spectral algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
@@ -0,0 +1,49 @@
+package spectral_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/spectral"
+	"golang.org/x/exp/rand"
+)
+
+var space = euclid.Space{}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = spectral.Conf{K: 1, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = spectral.NewAlgo(conf, space, nil)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_RunSyncCentroids(t *testing.T) {
+	for _, affinity := range []spectral.Affinity{spectral.RBF, spectral.KNN} {
+		var conf = spectral.Conf{
+			K: 3, Affinity: affinity, Neighbors: 2,
+			RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1},
+		}
+		var algo = spectral.NewAlgo(conf, space, nil)
+
+		test.DoTestRunSyncPP(t, algo)
+		test.DoTestRunSyncCentroids(t, algo)
+	}
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = spectral.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1000}}
+	var algo = spectral.NewAlgo(conf, space, nil)
+
+	test.DoTestWorkflow(t, algo)
+}
+
+func Test_Empty(t *testing.T) {
+	var conf = spectral.Conf{K: 3, CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = spectral.NewAlgo(conf, space, nil)
+
+	if err := algo.Batch(); err == nil {
+		t.Error("error expected")
+	}
+}
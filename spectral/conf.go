@@ -0,0 +1,54 @@
+package spectral
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of spectral clustering
+type Conf struct {
+	core.CtrlConf
+	K          int
+	Affinity   Affinity
+	Sigma      float64 // width of the RBF kernel, the median distance between elements if 0
+	Neighbors  int     // number of neighbors of the KNN affinity, 10 if 0
+	KMeansIter int     // number of kmeans iterations over the embedding, 20 if 0
+	FrameSize  int
+	RGen       *rand.Rand
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	switch {
+	case conf.K < 1:
+		err = fmt.Errorf("Illegal value for K: %v", conf.K)
+	case conf.Affinity < RBF || conf.Affinity > KNN:
+		err = fmt.Errorf("Illegal value for Affinity: %v", conf.Affinity)
+	case conf.Sigma < 0:
+		err = fmt.Errorf("Illegal value for Sigma: %v", conf.Sigma)
+	case conf.Neighbors < 1:
+		err = fmt.Errorf("Illegal value for Neighbors: %v", conf.Neighbors)
+	case conf.KMeansIter < 1:
+		err = fmt.Errorf("Illegal value for KMeansIter: %v", conf.KMeansIter)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.Neighbors == 0 {
+		conf.Neighbors = 10
+	}
+	if conf.KMeansIter == 0 {
+		conf.KMeansIter = 20
+	}
+}
@@ -0,0 +1,50 @@
+package spectral_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/spectral"
+)
+
+func TestSpectral_ConfErrorK(t *testing.T) {
+	var conf = spectral.Conf{}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSpectral_ConfErrorAffinity(t *testing.T) {
+	var conf = spectral.Conf{K: 2, Affinity: spectral.Affinity(12)}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSpectral_ConfErrorSigma(t *testing.T) {
+	var conf = spectral.Conf{K: 2, Sigma: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSpectral_ConfErrorNeighbors(t *testing.T) {
+	var conf = spectral.Conf{K: 2, Affinity: spectral.KNN, Neighbors: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestSpectral_Conf(t *testing.T) {
+	var conf = spectral.Conf{K: 2}
+	var err = conf.Verify()
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if conf.Neighbors != 10 || conf.KMeansIter != 20 || conf.RGen == nil {
+		t.Error("default values expected")
+	}
+}
@@ -0,0 +1,7 @@
+package spectral
+
+const (
+	// Eigengap is the difference between the K-th and the K+1-th eigenvalues of the normalized affinity matrix.
+	// A large eigengap indicates that K clusters are well separated.
+	Eigengap = "eigengap"
+)
@@ -0,0 +1,225 @@
+package spectral
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+// Impl algorithm implementation.
+// The embedding and its clustering are computed again only when elements have been pushed since the last iteration.
+type Impl struct {
+	buffer    core.Buffer
+	pushed    int64 // number of buffered elements, accessed atomically
+	applied   int64 // number of buffered elements when the embedding was last computed
+	computed  bool  // embedding is up to date with buffered elements
+	mu        sync.RWMutex
+	embedding []core.Elemt
+	eigengap  float64
+	labels    []int
+	stats     []core.ClusterStats
+}
+
+// NewImpl creates a new spectral clustering implementation
+func NewImpl(conf Conf, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer: core.NewDataBuffer(data, conf.FrameSize),
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	var conf = model.Conf().(*Conf)
+	impl.applied = atomic.LoadInt64(&impl.pushed)
+	impl.computed = false
+	_ = impl.buffer.Apply()
+	if len(impl.buffer.Data()) < conf.K {
+		err = errors.New("at least K elements are needed")
+		return
+	}
+	clust, _, err = impl.compute(model)
+	return
+}
+
+// Iterate computes the embedding again and clusters it with kmeans if elements have been pushed
+// since the last iteration
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var pushed = atomic.LoadInt64(&impl.pushed)
+	if err = impl.buffer.Apply(); err != nil {
+		return
+	}
+	if pushed != impl.applied {
+		impl.applied = pushed
+		impl.computed = false
+	}
+	return impl.compute(model)
+}
+
+// compute builds and clusters the embedding if needed and returns representatives in the original space
+func (impl *Impl) compute(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+	var space = model.Space()
+
+	// the embedding and its clustering are kept until elements are pushed
+	var embedding, eigengap, labels = impl.embedding, impl.eigengap, impl.labels
+	if !impl.computed {
+		var affinities [][]float64
+		switch conf.Affinity {
+		case KNN:
+			affinities = KNNMatrix(data, space, conf.Neighbors)
+		default:
+			affinities = RBFMatrix(data, space, conf.Sigma)
+		}
+		var rows [][]float64
+		if rows, eigengap, err = Embedding(affinities, weights, conf.K); err != nil {
+			return
+		}
+		embedding = make([]core.Elemt, len(rows))
+		for i := range rows {
+			embedding[i] = rows[i]
+		}
+		if labels, err = cluster(conf, embedding, weights); err != nil {
+			return
+		}
+		impl.computed = true
+	}
+
+	var k int
+	labels, k = relabel(labels, conf.K)
+	clust = representatives(k, data, weights, labels, space)
+	var stats = make([]core.ClusterStats, k)
+	for i, label := range labels {
+		core.AddToStats(&stats[label], core.Weight(weights, i), space.Dist(data[i], clust[label]), norm)
+	}
+
+	impl.mu.Lock()
+	impl.embedding, impl.eigengap, impl.labels, impl.stats = embedding, eigengap, labels, stats
+	impl.mu.Unlock()
+
+	runtimeFigures = core.RuntimeFigures{
+		Eigengap: eigengap,
+	}
+	return
+}
+
+// cluster runs kmeans over the embedding and returns embedded element labels
+func cluster(conf *Conf, embedding []core.Elemt, weights []int) (labels []int, err error) {
+	var space = euclid.Space{}
+	var centroids core.Clust
+	if centroids, err = kmeans.PPInitializer(conf.K, embedding, space, conf.RGen); err != nil {
+		return
+	}
+	var strategy = kmeans.SeqStrategy{}
+	for i := 0; i < conf.KMeansIter; i++ {
+		centroids = strategy.Iterate(space, centroids, embedding, weights)
+	}
+	labels, _ = centroids.MapLabel(embedding, space)
+	return
+}
+
+// relabel removes empty clusters from labels and returns the number of remaining clusters
+func relabel(labels []int, k int) (result []int, count int) {
+	var index = make([]int, k)
+	for i := range index {
+		index[i] = -1
+	}
+	result = make([]int, len(labels))
+	for i, label := range labels {
+		if index[label] < 0 {
+			index[label] = count
+			count++
+		}
+		result[i] = index[label]
+	}
+	return
+}
+
+// representatives returns the weighted average of each of the k clusters in the original space
+func representatives(k int, data []core.Elemt, weights []int, labels []int, space core.Space) (clust core.Clust) {
+	var members = make([][]core.Elemt, k)
+	var memberWeights = make([][]int, k)
+	for i, label := range labels {
+		members[label] = append(members[label], data[i])
+		memberWeights[label] = append(memberWeights[label], core.Weight(weights, i))
+	}
+	clust = make(core.Clust, k)
+	for label := range clust {
+		clust[label], _ = core.WeightedDBA(members[label], memberWeights[label], space)
+	}
+	return
+}
+
+// Embedding returns the spectral embedding of buffered elements computed by the last iteration
+func (impl *Impl) Embedding() [][]float64 {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	var embedding = make([][]float64, len(impl.embedding))
+	for i := range impl.embedding {
+		embedding[i] = impl.embedding[i].([]float64)
+	}
+	return embedding
+}
+
+// Labels returns the labels of buffered elements given by the last iteration
+func (impl *Impl) Labels() []int {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	var labels = make([]int, len(impl.labels))
+	copy(labels, impl.labels)
+	return labels
+}
+
+// Stats returns cluster statistics of the last iteration
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) (err error) {
+	if err = impl.buffer.Push(elemt, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) (err error) {
+	if err = impl.buffer.PushWeighted(elemt, weight, model.Status().Alive()); err == nil {
+		atomic.AddInt64(&impl.pushed, 1)
+	}
+	return
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	impl.computed = false
+	return impl.buffer.Load(r)
+}
@@ -0,0 +1,142 @@
+package spectral_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/spectral"
+	"golang.org/x/exp/rand"
+)
+
+// rings returns two concentric rings that kmeans can not separate
+func rings() (data []core.Elemt) {
+	for i := 0; i < 12; i++ {
+		var angle = 2 * math.Pi * float64(i) / 12
+		data = append(data, []float64{math.Cos(angle), math.Sin(angle)})
+	}
+	for i := 0; i < 24; i++ {
+		var angle = 2 * math.Pi * float64(i) / 24
+		data = append(data, []float64{5 * math.Cos(angle), 5 * math.Sin(angle)})
+	}
+	return
+}
+
+func TestImpl_Rings(t *testing.T) {
+	var conf = spectral.Conf{
+		K: 2, Affinity: spectral.KNN, Neighbors: 2,
+		RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1},
+	}
+	var algo = spectral.NewAlgo(conf, space, rings())
+	test.AssertNoError(t, algo.Batch())
+
+	var labels = algo.Impl().(*spectral.Impl).Labels()
+	for i := range labels {
+		if i < 12 {
+			test.AssertEqual(t, labels[0], labels[i])
+		} else {
+			test.AssertEqual(t, 1-labels[0], labels[i])
+		}
+	}
+	// both rings are centered on the origin
+	for _, centroid := range algo.Centroids() {
+		test.AssertArrayAlmostEqual(t, []float64{0, 0}, roundArray(centroid.([]float64)))
+	}
+	test.AssertTrue(t, algo.RuntimeFigures()[spectral.Eigengap] > 0)
+}
+
+func TestImpl_Push(t *testing.T) {
+	var conf = spectral.Conf{K: 2, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = spectral.NewAlgo(conf, space, test.Vectors[:3])
+	test.AssertNoError(t, algo.Batch())
+	test.AssertEqual(t, 2, len(algo.Centroids()))
+
+	test.AssertNoError(t, algo.Push([]float64{-9, -10, -8, -8, -7.5}))
+	test.AssertNoError(t, algo.Batch())
+	test.AssertEqual(t, 4, len(algo.Impl().(*spectral.Impl).Labels()))
+	test.AssertEqual(t, 4, len(algo.Impl().(*spectral.Impl).Embedding()))
+}
+
+func TestImpl_Settled(t *testing.T) {
+	var conf = spectral.Conf{K: 4, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = spectral.NewAlgo(conf, space, test.Vectors)
+	test.AssertNoError(t, algo.Batch())
+	var impl = algo.Impl().(*spectral.Impl)
+	var labels = impl.Labels()
+
+	for i := 0; i < 10; i++ {
+		var clust, _, err = impl.Iterate(algo)
+		test.AssertNoError(t, err)
+		test.AssertEqual(t, labels, impl.Labels())
+		test.AssertCentroids(t, algo.Centroids(), clust)
+	}
+}
+
+func TestImpl_PushWeighted(t *testing.T) {
+	var conf = spectral.Conf{K: 3, Sigma: 10, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+	var weighted = spectral.NewAlgo(conf, space, nil)
+	var duplicated = spectral.NewAlgo(conf, space, nil)
+	for i, elemt := range test.Vectors {
+		var weight = i%3 + 1
+		test.AssertNoError(t, weighted.PushWeighted(elemt, weight))
+		for j := 0; j < weight; j++ {
+			_ = duplicated.Push(elemt)
+		}
+	}
+
+	test.AssertNoError(t, weighted.Batch())
+	test.AssertNoError(t, duplicated.Batch())
+	assertPredict(t, duplicated, weighted)
+
+	var copied, err = weighted.Copy(&conf, space)
+	test.AssertNoError(t, err)
+	test.AssertNoError(t, copied.Batch())
+	assertPredict(t, weighted, copied)
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var conf = spectral.Conf{K: 3, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = spectral.NewAlgo(conf, space, test.Vectors)
+	test.AssertNoError(t, algo.Batch())
+
+	var stats = algo.Stats()
+	test.AssertEqual(t, 3, len(stats))
+	var size = 0
+	for _, s := range stats {
+		size += s.Size
+	}
+	test.AssertEqual(t, len(test.Vectors), size)
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = spectral.Conf{K: 3, RGen: rand.New(rand.NewSource(6305689164243)), CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = spectral.NewAlgo(conf, space, test.Vectors)
+	test.AssertNoError(t, algo.Batch())
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+	var impl = spectral.NewImpl(conf, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	test.AssertNoError(t, restored.Batch())
+	assertPredict(t, algo, restored)
+}
+
+func assertPredict(t *testing.T, expected core.OnlineClust, actual core.OnlineClust) {
+	for _, elemt := range test.Vectors {
+		var c1, _, _ = expected.Predict(elemt)
+		var c2, _, _ = actual.Predict(elemt)
+		test.AssertArrayAlmostEqual(t, c1.([]float64), c2.([]float64))
+	}
+}
+
+func roundArray(x []float64) []float64 {
+	var result = make([]float64, len(x))
+	for i := range x {
+		result[i] = math.Round(x[i]*1e6) / 1e6
+	}
+	return result
+}
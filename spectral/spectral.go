@@ -0,0 +1,66 @@
+package spectral
+
+import (
+	"errors"
+	"math"
+
+	"github.com/wearelumenai/distclus/core"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrEigen raised when the eigen decomposition of the normalized affinity matrix fails
+var ErrEigen = errors.New("eigen decomposition failed")
+
+// Embedding returns the spectral embedding of weighted elements given their affinity matrix:
+// rows of the k leading eigenvectors of the normalized affinity matrix, scaled to unit norm.
+// The leading eigenvectors of the normalized affinity are the first ones of the normalized Laplacian.
+// Weights stand for element multiplicities and nil weights for unit weights.
+// The eigengap is the difference between the k-th and the k+1-th eigenvalues.
+func Embedding(affinities [][]float64, weights []int, k int) (embedding [][]float64, eigengap float64, err error) {
+	var size = len(affinities)
+	var degrees = make([]float64, size)
+	for i := range affinities {
+		for j := range affinities[i] {
+			degrees[i] += affinities[i][j] * float64(core.Weight(weights, j))
+		}
+	}
+
+	// D^-1/2 W D^-1/2 with multiplicities, symmetrized by their square roots
+	var normalized = mat.NewSymDense(size, nil)
+	for i := range affinities {
+		for j := 0; j <= i; j++ {
+			var wij = math.Sqrt(float64(core.Weight(weights, i) * core.Weight(weights, j)))
+			normalized.SetSym(i, j, wij*affinities[i][j]/math.Sqrt(degrees[i]*degrees[j]))
+		}
+	}
+	var eigen mat.EigenSym
+	if !eigen.Factorize(normalized, true) {
+		return nil, 0, ErrEigen
+	}
+	var values = eigen.Values(nil) // ascending order
+	var vectors mat.Dense
+	eigen.VectorsTo(&vectors)
+
+	if k > size {
+		k = size
+	}
+	if k < size {
+		eigengap = values[size-k] - values[size-k-1]
+	}
+	embedding = make([][]float64, size)
+	for i := range embedding {
+		embedding[i] = make([]float64, k)
+		var norm = 0.
+		for c := 0; c < k; c++ {
+			var x = vectors.At(i, size-1-c)
+			embedding[i][c] = x
+			norm += x * x
+		}
+		if norm = math.Sqrt(norm); norm > 0 {
+			for c := range embedding[i] {
+				embedding[i][c] /= norm
+			}
+		}
+	}
+	return
+}
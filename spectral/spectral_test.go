@@ -0,0 +1,59 @@
+package spectral_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/spectral"
+)
+
+// two disconnected blocks
+var blocks = [][]float64{
+	{1, 1, 1, 0, 0},
+	{1, 1, 1, 0, 0},
+	{1, 1, 1, 0, 0},
+	{0, 0, 0, 1, 1},
+	{0, 0, 0, 1, 1},
+}
+
+func TestEmbedding(t *testing.T) {
+	var embedding, eigengap, err = spectral.Embedding(blocks, nil, 2)
+	test.AssertNoError(t, err)
+
+	test.AssertEqual(t, 5, len(embedding))
+	test.AssertArrayAlmostEqual(t, embedding[0], embedding[2])
+	test.AssertArrayAlmostEqual(t, embedding[3], embedding[4])
+	test.AssertAlmostEqual(t, 0, dot(embedding[0], embedding[3]))
+	test.AssertAlmostEqual(t, 1, dot(embedding[1], embedding[1]))
+	// leading eigenvalues are 1, the next ones are 0
+	test.AssertAlmostEqual(t, 1, eigengap)
+}
+
+func TestEmbedding_Weighted(t *testing.T) {
+	var weighted = [][]float64{
+		{1, 1, 0},
+		{1, 1, .5},
+		{0, .5, 1},
+	}
+	var duplicated = [][]float64{
+		{1, 1, 1, 0},
+		{1, 1, 1, 0},
+		{1, 1, 1, .5},
+		{0, 0, .5, 1},
+	}
+	var embedding, _, err = spectral.Embedding(weighted, []int{2, 1, 1}, 2)
+	test.AssertNoError(t, err)
+	var expected, _, _ = spectral.Embedding(duplicated, nil, 2)
+
+	for i, j := range []int{1, 2, 3} {
+		test.AssertAlmostEqual(t, math.Abs(dot(expected[1], expected[j])), math.Abs(dot(embedding[0], embedding[i])))
+	}
+}
+
+func dot(x, y []float64) (result float64) {
+	for i := range x {
+		result += x[i] * y[i]
+	}
+	return
+}
@@ -1,7 +1,9 @@
 package streaming
 
 import (
+	"encoding/gob"
 	"errors"
+	"io"
 
 	"github.com/wearelumenai/distclus/core"
 
@@ -12,13 +14,22 @@ import (
 type Impl struct {
 	maxDistance float64
 	clust       core.Clust
-	cards       []int
-	c           chan core.Elemt
+	stats       []core.ClusterStats
+	c           chan weightedElemt
 	conf        Conf
 	norm        distuv.Normal
 	count       int
 }
 
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// weightedElemt is a pending element
+type weightedElemt struct {
+	elemt  core.Elemt
+	weight int
+}
+
 // Copy impl
 func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
 	var conf = model.Conf().(*Conf)
@@ -28,9 +39,9 @@ func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
 
 // NewImpl creates a new Impl instance.
 func NewImpl(conf Conf, elemts []core.Elemt) Impl {
-	var c = make(chan core.Elemt, conf.BufferSize)
+	var c = make(chan weightedElemt, conf.BufferSize)
 	for i := range elemts {
-		c <- elemts[i]
+		c <- weightedElemt{elemts[i], 1}
 	}
 	return Impl{
 		c:    c,
@@ -46,11 +57,9 @@ func NewImpl(conf Conf, elemts []core.Elemt) Impl {
 // Init initializes the streaming algorithm.
 func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
 	select {
-	case centroids := <-impl.c:
-		clust = core.Clust{centroids}
-		for i := range clust {
-			impl.AddCenter(clust[i], 0.)
-		}
+	case pending := <-impl.c:
+		clust = core.Clust{pending.elemt}
+		impl.addCenter(pending.elemt, pending.weight, 0.)
 	default:
 		err = errors.New("at least one element is needed")
 	}
@@ -60,8 +69,8 @@ func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
 // Iterate runs the streaming algorithm.
 func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
 	select {
-	case elemt := <-impl.c:
-		impl.Process(elemt, model.Space())
+	case pending := <-impl.c:
+		impl.ProcessWeighted(pending.elemt, pending.weight, model.Space())
 		clust = impl.clust
 	default:
 	}
@@ -75,8 +84,13 @@ func (impl *Impl) runtimeFigures() core.RuntimeFigures {
 
 // Push pushes a new element
 func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) (err error) {
+	return impl.PushWeighted(elemt, 1, model)
+}
+
+// PushWeighted pushes a new element standing for weight observations
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) (err error) {
 	select {
-	case impl.c <- elemt:
+	case impl.c <- weightedElemt{elemt, weight}:
 	default:
 		err = errors.New("buffer is full")
 	}
@@ -105,25 +119,43 @@ func (impl *Impl) GetRelativeDistance(distance float64) float64 {
 
 // AddCenter adds a new center.
 func (impl *Impl) AddCenter(cluster core.Elemt, distance float64) {
+	impl.addCenter(cluster, 1, distance)
+}
+
+func (impl *Impl) addCenter(cluster core.Elemt, weight int, distance float64) {
 	impl.clust = append(impl.clust, cluster)
-	impl.cards = append(impl.cards, 1)
+	impl.stats = append(impl.stats, core.ClusterStats{Size: weight})
 	impl.UpdateMaxDistance(distance)
 }
 
 // AddOutlier adds an outlier.
 func (impl *Impl) AddOutlier(outlier core.Elemt) {
+	impl.addOutlier(outlier, 1)
+}
+
+func (impl *Impl) addOutlier(outlier core.Elemt, weight int) {
 	impl.clust = append(impl.clust, outlier)
-	impl.cards = append(impl.cards, 1)
+	impl.stats = append(impl.stats, core.ClusterStats{Size: weight})
 }
 
 // UpdateCenter modifies an existing center.
 func (impl *Impl) UpdateCenter(label int, elemt core.Elemt, distance float64, space core.Space) {
-	var cluster = space.Combine(impl.clust[label], impl.cards[label], elemt, 1)
+	impl.updateCenter(label, elemt, 1, distance, space)
+}
+
+func (impl *Impl) updateCenter(label int, elemt core.Elemt, weight int, distance float64, space core.Space) {
+	var cluster = space.Combine(impl.clust[label], impl.stats[label].Size, elemt, weight)
 	impl.clust[label] = cluster
-	impl.cards[label]++
+	core.AddToStats(&impl.stats[label], weight, distance, norm)
 	impl.UpdateMaxDistance(distance)
 }
 
+// Stats returns cluster statistics.
+// Distances are computed when elements are processed, before centers update.
+func (impl *Impl) Stats() []core.ClusterStats {
+	return impl.stats
+}
+
 // GetClusters returns the current cluster centers.
 func (impl *Impl) GetClusters() core.Clust {
 	return impl.clust
@@ -131,18 +163,102 @@ func (impl *Impl) GetClusters() core.Clust {
 
 // Process a streaming iteration.
 func (impl *Impl) Process(elemt core.Elemt, space core.Space) {
+	impl.ProcessWeighted(elemt, 1, space)
+}
+
+// ProcessWeighted processes a streaming iteration with an element standing for weight observations.
+func (impl *Impl) ProcessWeighted(elemt core.Elemt, weight int, space core.Space) {
 	var _, label, distance = impl.clust.Assign(elemt, space)
 	var relative = impl.GetRelativeDistance(distance)
 
 	if impl.count >= impl.conf.OutAfter && relative > impl.conf.OutRatio {
-		impl.AddOutlier(elemt)
+		impl.addOutlier(elemt, weight)
 	} else {
 		var threshold = impl.norm.Rand()
 		if threshold < relative {
-			impl.AddCenter(elemt, distance)
+			impl.addCenter(elemt, weight, distance)
 		} else {
-			impl.UpdateCenter(label, elemt, distance, space)
+			impl.updateCenter(label, elemt, weight, distance, space)
 		}
 	}
 	impl.count++
 }
+
+// implState is the persisted form of an Impl
+type implState struct {
+	MaxDistance float64
+	Clust       core.Clust
+	Cards       []int
+	Losses      []float64
+	Radii       []float64
+	Count       int
+	Pending     []core.Elemt
+	Weights     []int
+}
+
+// Save writes clusters, cardinalities, maximal distance and pending elements.
+// Pending elements remain pending.
+func (impl *Impl) Save(w io.Writer) (err error) {
+	var pending = impl.drain()
+	var state = implState{
+		MaxDistance: impl.maxDistance,
+		Clust:       impl.clust,
+		Cards:       make([]int, len(impl.stats)),
+		Losses:      make([]float64, len(impl.stats)),
+		Radii:       make([]float64, len(impl.stats)),
+		Count:       impl.count,
+	}
+	for i, s := range impl.stats {
+		state.Cards[i], state.Losses[i], state.Radii[i] = s.Size, s.Loss, s.Radius
+	}
+	for _, p := range pending {
+		state.Pending = append(state.Pending, p.elemt)
+		state.Weights = append(state.Weights, p.weight)
+	}
+	err = gob.NewEncoder(w).Encode(state)
+	_ = impl.enqueue(pending)
+	return
+}
+
+// Load replaces the implementation state with a saved one
+func (impl *Impl) Load(r io.Reader) (err error) {
+	var state implState
+	err = gob.NewDecoder(r).Decode(&state)
+	if err == nil {
+		impl.drain()
+		impl.maxDistance = state.MaxDistance
+		impl.clust = state.Clust
+		impl.stats = make([]core.ClusterStats, len(state.Cards))
+		for i := range impl.stats {
+			impl.stats[i].Size = state.Cards[i]
+			if i < len(state.Losses) {
+				impl.stats[i].Loss, impl.stats[i].Radius = state.Losses[i], state.Radii[i]
+			}
+		}
+		impl.count = state.Count
+		var pending = make([]weightedElemt, len(state.Pending))
+		for i := range pending {
+			pending[i] = weightedElemt{state.Pending[i], core.Weight(state.Weights, i)}
+		}
+		err = impl.enqueue(pending)
+	}
+	return
+}
+
+func (impl *Impl) drain() (pending []weightedElemt) {
+	for {
+		select {
+		case p := <-impl.c:
+			pending = append(pending, p)
+		default:
+			return
+		}
+	}
+}
+
+func (impl *Impl) enqueue(pending []weightedElemt) (err error) {
+	for i := 0; i < len(pending) && err == nil; i++ {
+		err = impl.PushWeighted(pending[i].elemt, pending[i].weight, nil)
+	}
+	return
+}
@@ -1,6 +1,7 @@
 package streaming_test
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -222,3 +223,63 @@ func TestImpl_Run(t *testing.T) {
 		t.Error("less than 6 clusters expected")
 	}
 }
+
+func TestImpl_SaveLoad(t *testing.T) {
+	var conf = streaming.Conf{BufferSize: 10}
+	conf.SetDefaultValues()
+	var impl = streaming.NewImpl(conf, []core.Elemt{[]float64{1.}, []float64{2.}, []float64{3.}})
+	var model = NewInitModel(&conf)
+	_, _ = impl.Init(model)
+	_, _, _ = impl.Iterate(NewIterateModel(&conf, impl.GetClusters()))
+
+	var buffer bytes.Buffer
+	if err := impl.Save(&buffer); err != nil {
+		t.Error("no error expected", err)
+	}
+
+	var loaded = streaming.NewImpl(conf, nil)
+	if err := loaded.Load(&buffer); err != nil {
+		t.Error("no error expected", err)
+	}
+	if !reflect.DeepEqual(impl.GetClusters(), loaded.GetClusters()) {
+		t.Error("expected same clusters")
+	}
+	if impl.GetMaxDistance() != loaded.GetMaxDistance() {
+		t.Error("expected same max distance")
+	}
+
+	var clust, _, _ = loaded.Iterate(NewIterateModel(&conf, loaded.GetClusters()))
+	if clust == nil {
+		t.Error("expected pending element to be processed")
+	}
+}
+
+func TestImpl_ProcessWeighted(t *testing.T) {
+	var conf = streaming.Conf{Mu: 2, Sigma: 0.001, OutRatio: 2, RGen: rand.New(rand.NewSource(6305689164243))}
+	var impl = streaming.NewImpl(conf, nil)
+	var _ core.WeightedImpl = &impl
+
+	impl.AddCenter(core.Elemt([]float64{0.}), 0.)
+	impl.ProcessWeighted(core.Elemt([]float64{3.}), 2, euclid.Space{})
+	if c0 := impl.GetClusters()[0]; !reflect.DeepEqual([]float64{2.}, c0) {
+		t.Error("expected cluster: ", []float64{2.}, "got", c0)
+	}
+	impl.Process(core.Elemt([]float64{6.}), euclid.Space{})
+	if c0 := impl.GetClusters()[0]; !reflect.DeepEqual([]float64{3.}, c0) {
+		t.Error("expected cluster: ", []float64{3.}, "got", c0)
+	}
+}
+
+func TestImpl_Stats(t *testing.T) {
+	var impl = streaming.Impl{}
+
+	impl.AddCenter(core.Elemt([]float64{1.}), 1.2)
+	impl.UpdateCenter(0, core.Elemt([]float64{2.}), 1., euclid.Space{})
+	impl.UpdateCenter(0, core.Elemt([]float64{4.}), 2.5, euclid.Space{})
+	impl.AddOutlier(core.Elemt([]float64{10.}))
+
+	var expected = []core.ClusterStats{{Size: 3, Loss: 7.25, Radius: 2.5}, {Size: 1}}
+	if stats := impl.Stats(); !reflect.DeepEqual(expected, stats) {
+		t.Error("Expected", expected, "got", stats)
+	}
+}
@@ -0,0 +1,13 @@
+// Package xmeans provides a kmeans implementation of online clustering whose number of clusters grows from data.
+// After kmeans convergence, each centroid is split in two if the split is accepted by a statistical test:
+// the bayesian information criterion of x-means or the Anderson-Darling normality test of g-means.
+package xmeans
+
+import "github.com/wearelumenai/distclus/core"
+
+// NewAlgo creates a new x-means algo
+func NewAlgo(conf Conf, space core.Space, data []core.Elemt, initializer core.Initializer, args ...interface{}) *core.Algo {
+	conf.Verify()
+	var impl = NewImpl(conf, initializer, data)
+	return core.NewAlgo(&conf, &impl, space)
+}
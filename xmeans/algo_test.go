@@ -0,0 +1,35 @@
+package xmeans_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/euclid"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/xmeans"
+)
+
+var space = euclid.Space{}
+
+func Test_Scenario_Batch(t *testing.T) {
+	var conf = xmeans.Conf{CtrlConf: core.CtrlConf{Iter: 1}}
+	var algo = xmeans.NewAlgo(conf, space, nil, kmeans.PPInitializer)
+
+	test.DoTestScenarioBatch(t, algo)
+}
+
+func Test_RunSyncGiven(t *testing.T) {
+	var conf = xmeans.Conf{InitK: 3, MaxK: 3, CtrlConf: core.CtrlConf{Iter: 20}}
+	var algo = xmeans.NewAlgo(conf, space, nil, kmeans.GivenInitializer)
+
+	test.DoTestRunSyncGiven(t, algo)
+	test.DoTestRunSyncCentroids(t, algo)
+}
+
+func Test_Workflow(t *testing.T) {
+	var conf = xmeans.Conf{InitK: 3, MaxK: 3, CtrlConf: core.CtrlConf{Iter: 1000000}}
+	var algo = xmeans.NewAlgo(conf, space, nil, kmeans.PPInitializer)
+
+	test.DoTestWorkflow(t, algo)
+}
@@ -0,0 +1,60 @@
+package xmeans
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wearelumenai/distclus/core"
+
+	"golang.org/x/exp/rand"
+)
+
+// Conf of x-means and g-means
+type Conf struct {
+	core.CtrlConf
+	InitK      int // initial number of clusters, 1 if 0
+	MaxK       int // maximal number of clusters, 16 if 0
+	Test       Test
+	Critical   float64 // critical value of the Anderson-Darling statistic, 1.8692 if 0 (significance level of 0.0001)
+	KMeansIter int     // maximal number of kmeans iterations between two split attempts, 10 if 0
+	FrameSize  int
+	RGen       *rand.Rand
+}
+
+// Verify configuration
+func (conf *Conf) Verify() (err error) {
+	conf.SetDefaultValues()
+	switch {
+	case conf.InitK < 1:
+		err = fmt.Errorf("Illegal value for K: %v", conf.InitK)
+	case conf.InitK > conf.MaxK:
+		err = fmt.Errorf("Illegal value for Max K / Init K: %v / %v", conf.MaxK, conf.InitK)
+	case conf.Test < BIC || conf.Test > AndersonDarling:
+		err = fmt.Errorf("Illegal value for Test: %v", conf.Test)
+	case conf.Critical < 0:
+		err = fmt.Errorf("Illegal value for Critical: %v", conf.Critical)
+	case conf.KMeansIter < 1:
+		err = fmt.Errorf("Illegal value for KMeansIter: %v", conf.KMeansIter)
+	}
+	return
+}
+
+// SetDefaultValues initializes nil configuration values
+func (conf *Conf) SetDefaultValues() {
+	if conf.RGen == nil {
+		var seed = uint64(time.Now().UTC().Unix())
+		conf.RGen = rand.New(rand.NewSource(seed))
+	}
+	if conf.InitK == 0 {
+		conf.InitK = 1
+	}
+	if conf.MaxK == 0 {
+		conf.MaxK = 16
+	}
+	if conf.Critical == 0 {
+		conf.Critical = 1.8692
+	}
+	if conf.KMeansIter == 0 {
+		conf.KMeansIter = 10
+	}
+}
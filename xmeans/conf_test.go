@@ -0,0 +1,42 @@
+package xmeans_test
+
+import (
+	"testing"
+
+	"github.com/wearelumenai/distclus/xmeans"
+)
+
+func TestXMeans_ConfErrorK(t *testing.T) {
+	var conf = xmeans.Conf{InitK: -1}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestXMeans_ConfErrorMaxK(t *testing.T) {
+	var conf = xmeans.Conf{InitK: 30, MaxK: 10}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestXMeans_ConfErrorTest(t *testing.T) {
+	var conf = xmeans.Conf{Test: xmeans.Test(12)}
+	var err = conf.Verify()
+	if err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestXMeans_Conf(t *testing.T) {
+	var conf = xmeans.Conf{}
+	var err = conf.Verify()
+	if err != nil {
+		t.Error("no error expected", err)
+	}
+	if conf.InitK != 1 || conf.MaxK != 16 || conf.Critical != 1.8692 || conf.KMeansIter != 10 {
+		t.Error("default values expected")
+	}
+}
@@ -0,0 +1,6 @@
+package xmeans
+
+const (
+	// Splits is the number of centroids split by the last iteration
+	Splits = "splits"
+)
@@ -0,0 +1,157 @@
+package xmeans
+
+import (
+	"io"
+	"sync"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+)
+
+// Impl algorithm implementation.
+// Each iteration is a kmeans iteration, split attempts occurring after convergence
+// or after KMeansIter iterations.
+type Impl struct {
+	buffer      core.Buffer
+	initializer core.Initializer
+	strategy    kmeans.SeqStrategy
+	iter        int // number of kmeans iterations since the last split attempt
+	mu          sync.RWMutex
+	stats       []core.ClusterStats
+}
+
+// NewImpl creates a new x-means implementation
+func NewImpl(conf Conf, initializer core.Initializer, data []core.Elemt) Impl {
+	conf.SetDefaultValues()
+	return Impl{
+		buffer:      core.NewDataBuffer(data, conf.FrameSize),
+		initializer: initializer,
+	}
+}
+
+// norm of the losses in cluster statistics
+const norm = 2.
+
+// Init Algorithm
+func (impl *Impl) Init(model core.OCModel) (clust core.Clust, err error) {
+	var conf = model.Conf().(*Conf)
+	_ = impl.buffer.Apply()
+	impl.iter = 0
+	return impl.initializer(conf.InitK, impl.buffer.Data(), model.Space(), conf.RGen)
+}
+
+// Iterate runs a kmeans iteration and tries to split centroids if kmeans has converged
+func (impl *Impl) Iterate(model core.OCModel) (clust core.Clust, runtimeFigures core.RuntimeFigures, err error) {
+	var conf = model.Conf().(*Conf)
+	var space = model.Space()
+	var data, weights = impl.buffer.Data(), impl.buffer.Weights()
+
+	var centroids = model.Centroids()
+	clust = impl.strategy.Iterate(space, centroids, data, weights)
+	impl.iter++
+	var splits = 0
+	if impl.iter >= conf.KMeansIter || converged(centroids, clust, space) {
+		impl.iter = 0
+		clust, splits = split(conf, clust, data, weights, space)
+	}
+
+	var stats = impl.strategy.Stats()
+	if splits > 0 {
+		stats = clust.ReduceStats(data, weights, space, norm)
+	}
+	impl.mu.Lock()
+	impl.stats = stats
+	impl.mu.Unlock()
+
+	runtimeFigures = core.RuntimeFigures{
+		Splits: float64(splits),
+	}
+	err = impl.buffer.Apply()
+	return
+}
+
+// converged returns true if no centroid has moved
+func converged(centroids core.Clust, clust core.Clust, space core.Space) bool {
+	for i := range centroids {
+		if space.Dist(centroids[i], clust[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// split replaces centroids by their children when the test accepts the split, at most MaxK centroids being kept
+func split(conf *Conf, clust core.Clust, data []core.Elemt, weights []int, space core.Space) (result core.Clust, splits int) {
+	var members = make([][]core.Elemt, len(clust))
+	var memberWeights = make([][]int, len(clust))
+	var labels, _ = clust.MapLabel(data, space)
+	for i, label := range labels {
+		members[label] = append(members[label], data[i])
+		memberWeights[label] = append(memberWeights[label], core.Weight(weights, i))
+	}
+
+	result = make(core.Clust, 0, len(clust))
+	for label, centroid := range clust {
+		if len(clust)+splits >= conf.MaxK || len(members[label]) < 2 {
+			result = append(result, centroid)
+			continue
+		}
+		var children, err = Split(centroid, members[label], memberWeights[label], space, conf.KMeansIter, conf.RGen)
+		if err == nil && accept(conf, centroid, children, members[label], memberWeights[label], space) {
+			result = append(result, children...)
+			splits++
+		} else {
+			result = append(result, centroid)
+		}
+	}
+	return
+}
+
+// accept returns true if the test accepts the split of a centroid in children
+func accept(conf *Conf, centroid core.Elemt, children core.Clust, elemts []core.Elemt, weights []int, space core.Space) bool {
+	switch conf.Test {
+	case AndersonDarling:
+		var values = Project(elemts, children[0], children[1], space)
+		return ADStatistic(values, weights) > conf.Critical
+	default:
+		return kmeans.BIC(children, elemts, weights, space) > kmeans.BIC(core.Clust{centroid}, elemts, weights, space)
+	}
+}
+
+// Stats returns cluster statistics of the last iteration
+func (impl *Impl) Stats() []core.ClusterStats {
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return impl.stats
+}
+
+// Push input element in the buffer
+func (impl *Impl) Push(elemt core.Elemt, model core.OCModel) error {
+	return impl.buffer.Push(elemt, model.Status().Alive())
+}
+
+// PushWeighted input weighted element in the buffer
+func (impl *Impl) PushWeighted(elemt core.Elemt, weight int, model core.OCModel) error {
+	return impl.buffer.PushWeighted(elemt, weight, model.Status().Alive())
+}
+
+// Copy impl
+func (impl *Impl) Copy(model core.OCModel) (core.Impl, error) {
+	var newConf = model.Conf().(*Conf)
+	var newImpl = NewImpl(*newConf, impl.initializer, nil)
+	var err error
+	if newImpl.buffer, err = core.CopyBuffer(impl.buffer, newConf.FrameSize); err != nil {
+		return nil, err
+	}
+	return &newImpl, nil
+}
+
+// Save writes buffered data
+func (impl *Impl) Save(w io.Writer) error {
+	return impl.buffer.Save(w)
+}
+
+// Load replaces buffered data with saved ones
+func (impl *Impl) Load(r io.Reader) error {
+	return impl.buffer.Load(r)
+}
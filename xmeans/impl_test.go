@@ -0,0 +1,80 @@
+package xmeans_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/kmeans"
+	"github.com/wearelumenai/distclus/xmeans"
+	"golang.org/x/exp/rand"
+)
+
+// blobs returns 4 aligned gaussian blobs of 50 vectors
+func blobs() (data []core.Elemt) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var centers = [][]float64{{0, 0}, {20, 0}, {40, 0}, {60, 0}}
+	for _, center := range centers {
+		for i := 0; i < 50; i++ {
+			data = append(data, []float64{center[0] + rgen.NormFloat64(), center[1] + rgen.NormFloat64()})
+		}
+	}
+	return
+}
+
+func newConf(test xmeans.Test) xmeans.Conf {
+	return xmeans.Conf{
+		Test:     test,
+		RGen:     rand.New(rand.NewSource(6305689164243)),
+		CtrlConf: core.CtrlConf{Iter: 50},
+	}
+}
+
+func TestImpl_Grow(t *testing.T) {
+	for _, splitTest := range []xmeans.Test{xmeans.BIC, xmeans.AndersonDarling} {
+		var algo = xmeans.NewAlgo(newConf(splitTest), space, blobs(), kmeans.PPInitializer)
+		test.AssertNoError(t, algo.Batch())
+
+		test.AssertEqual(t, 4, len(algo.Centroids()))
+		test.AssertEqual(t, 4, len(algo.Stats()))
+		var _, label0, _ = algo.Predict([]float64{0, 0})
+		var _, label1, _ = algo.Predict([]float64{60, 0})
+		test.AssertTrue(t, label0 != label1)
+	}
+}
+
+func TestImpl_MaxK(t *testing.T) {
+	var conf = newConf(xmeans.BIC)
+	conf.MaxK = 2
+	var algo = xmeans.NewAlgo(conf, space, blobs(), kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	test.AssertEqual(t, 2, len(algo.Centroids()))
+}
+
+func TestImpl_Splits(t *testing.T) {
+	var conf = newConf(xmeans.BIC)
+	conf.Iter = 1
+	conf.KMeansIter = 1
+	var algo = xmeans.NewAlgo(conf, space, blobs(), kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	test.AssertEqual(t, 1., algo.RuntimeFigures()[xmeans.Splits])
+	test.AssertEqual(t, 2, len(algo.Centroids()))
+}
+
+func TestImpl_Snapshot(t *testing.T) {
+	var conf = newConf(xmeans.BIC)
+	var algo = xmeans.NewAlgo(conf, space, blobs(), kmeans.PPInitializer)
+	test.AssertNoError(t, algo.Batch())
+
+	var buffer bytes.Buffer
+	test.AssertNoError(t, algo.Snapshot(&buffer))
+	var impl = xmeans.NewImpl(conf, kmeans.PPInitializer, nil)
+	var restored, err = core.Restore(&buffer, &conf, &impl, space)
+	test.AssertNoError(t, err)
+
+	test.AssertNoError(t, restored.Batch())
+	test.AssertEqual(t, 4, len(restored.Centroids()))
+}
@@ -0,0 +1,109 @@
+package xmeans
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/kmeans"
+
+	"golang.org/x/exp/rand"
+)
+
+// Test decides whether a centroid is split in two
+type Test int
+
+const (
+	// BIC splits a centroid if its children increase the bayesian information criterion (x-means)
+	BIC Test = iota
+	// AndersonDarling splits a centroid if its elements projected on the axis of its children
+	// are not normally distributed (g-means)
+	AndersonDarling
+)
+
+// String returns the test name
+func (test Test) String() string {
+	switch test {
+	case BIC:
+		return "bic"
+	case AndersonDarling:
+		return "anderson-darling"
+	}
+	return fmt.Sprintf("Test(%d)", int(test))
+}
+
+// Split returns the two children of a centroid given its weighted elements.
+// The second child is drawn with kmeans++ then children are refined by iter kmeans iterations.
+// Nil weights stand for unit weights.
+func Split(centroid core.Elemt, elemts []core.Elemt, weights []int, space core.Space, iter int, rgen *rand.Rand) (children core.Clust, err error) {
+	var child core.Elemt
+	if child, err = kmeans.PPIter(core.Clust{centroid}, elemts, space, rgen); err != nil {
+		return
+	}
+	children = core.Clust{space.Copy(centroid), child}
+	for i := 0; i < iter; i++ {
+		var next, cards = children.ReduceWeightedDBA(elemts, weights, space)
+		if cards[0] == 0 || cards[1] == 0 {
+			break
+		}
+		children = next
+	}
+	return
+}
+
+// Project returns the coordinates of elements on the axis going through two centroids.
+// Coordinates are computed from distances so that any space can be used.
+func Project(elemts []core.Elemt, centroid1 core.Elemt, centroid2 core.Elemt, space core.Space) (values []float64) {
+	var d = space.Dist(centroid1, centroid2)
+	values = make([]float64, len(elemts))
+	if d == 0 {
+		return
+	}
+	for i, elemt := range elemts {
+		var d1, d2 = space.Dist(elemt, centroid1), space.Dist(elemt, centroid2)
+		values[i] = (d1*d1 - d2*d2) / (2 * d)
+	}
+	return
+}
+
+// ADStatistic returns the Anderson-Darling statistic of weighted values against a normal distribution
+// whose mean and variance are estimated, corrected for the sample size.
+// Weights are not expanded: each value contributes to the sum over the ranks it covers in the sorted sample.
+// Nil weights stand for unit weights.
+func ADStatistic(values []float64, weights []int) float64 {
+	var n, mean = 0., 0.
+	for i, value := range values {
+		var w = float64(core.Weight(weights, i))
+		n += w
+		mean += w * value
+	}
+	if n < 2 {
+		return 0
+	}
+	mean /= n
+	var variance = 0.
+	for i, value := range values {
+		variance += float64(core.Weight(weights, i)) * (value - mean) * (value - mean)
+	}
+	var std = math.Sqrt(variance / (n - 1))
+	if std == 0 {
+		return 0
+	}
+	var order = make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+	// ranks c+1..c+w of a value sum to w(2c+w) for ln F and to w(2n-2c-w) for ln(1-F)
+	var sum, c = 0., 0.
+	for _, i := range order {
+		var w = float64(core.Weight(weights, i))
+		var f = .5 * math.Erfc(-(values[i]-mean)/std/math.Sqrt2)
+		f = math.Min(math.Max(f, 1e-15), 1-1e-15)
+		sum += w*(2*c+w)*math.Log(f) + w*(2*n-2*c-w)*math.Log(1-f)
+		c += w
+	}
+	var a2 = -n - sum/n
+	return a2 * (1 + 4/n - 25/(n*n))
+}
@@ -0,0 +1,71 @@
+package xmeans_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/wearelumenai/distclus/core"
+	"github.com/wearelumenai/distclus/internal/test"
+	"github.com/wearelumenai/distclus/xmeans"
+	"golang.org/x/exp/rand"
+)
+
+var line = []core.Elemt{[]float64{0}, []float64{1}, []float64{10}, []float64{11}}
+
+func TestSplit(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var children, err = xmeans.Split([]float64{5.5}, line, nil, space, 10, rgen)
+	test.AssertNoError(t, err)
+
+	var values = []float64{children[0].([]float64)[0], children[1].([]float64)[0]}
+	sort.Float64s(values)
+	test.AssertArrayAlmostEqual(t, []float64{.5, 10.5}, values)
+}
+
+func TestSplit_Weighted(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var children, err = xmeans.Split([]float64{5.5}, line, []int{3, 1, 1, 1}, space, 10, rgen)
+	test.AssertNoError(t, err)
+
+	var values = []float64{children[0].([]float64)[0], children[1].([]float64)[0]}
+	sort.Float64s(values)
+	test.AssertArrayAlmostEqual(t, []float64{.25, 10.5}, values)
+}
+
+func TestSplit_Error(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var same = []core.Elemt{[]float64{1}, []float64{1}}
+	var _, err = xmeans.Split([]float64{1}, same, nil, space, 10, rgen)
+	test.AssertError(t, err)
+}
+
+func TestProject(t *testing.T) {
+	var elemts = []core.Elemt{[]float64{1, 0}, []float64{3, 0}, []float64{1, 5}}
+	var values = xmeans.Project(elemts, []float64{0, 0}, []float64{2, 0}, space)
+	test.AssertArrayAlmostEqual(t, []float64{0, 2, 0}, values)
+}
+
+func TestADStatistic(t *testing.T) {
+	var rgen = rand.New(rand.NewSource(6305689164243))
+	var normal, bimodal = make([]float64, 200), make([]float64, 200)
+	for i := range normal {
+		normal[i] = rgen.NormFloat64()
+		bimodal[i] = rgen.NormFloat64() + float64(i%2)*10
+	}
+	test.AssertTrue(t, xmeans.ADStatistic(normal, nil) < 1.8692)
+	test.AssertTrue(t, xmeans.ADStatistic(bimodal, nil) > 1.8692)
+
+	var weighted = xmeans.ADStatistic([]float64{0, 1, 3}, []int{2, 1, 2})
+	var duplicated = xmeans.ADStatistic([]float64{0, 0, 1, 3, 3}, nil)
+	test.AssertAlmostEqual(t, duplicated, weighted)
+}
+
+func TestADStatistic_LargeWeight(t *testing.T) {
+	var values = []float64{-2, -1, 0, 1, 2}
+	var weighted = xmeans.ADStatistic(values, []int{1, 2, 3, 2, 1})
+	var scaled = xmeans.ADStatistic(values, []int{1e9, 2e9, 3e9, 2e9, 1e9})
+	test.AssertTrue(t, !math.IsNaN(scaled) && !math.IsInf(scaled, 0))
+	test.AssertTrue(t, scaled > weighted)
+	test.AssertTrue(t, xmeans.ADStatistic([]float64{0, 10}, []int{1e9, 1e9}) > 1.8692)
+}